@@ -0,0 +1,11 @@
+package chain
+
+import "context"
+
+// Broadcaster submits a signed raw transaction to the network for a
+// given cryptocurrency, so Service doesn't need a separate code path
+// per chain (a full Bitcoin node, an Ethereum RPC endpoint, a
+// Tron/USDT gateway, ...).
+type Broadcaster interface {
+	Broadcast(ctx context.Context, cryptocurrency string, rawTx []byte) (txHash string, err error)
+}
@@ -0,0 +1,81 @@
+// Package chain bridges a validated compliance.Transaction to the
+// blockchain it settles on: broadcasting the signed transaction,
+// polling its confirmation depth, quoting the network fee to pay for
+// it, and notifying merchants/customers at each milestone through the
+// notification package. Country modules call into this package
+// instead of hand-rolling their own per-chain broadcast/poll code.
+package chain
+
+import (
+	"sync"
+)
+
+// TxStatus is a milestone in an on-chain transaction's lifecycle.
+type TxStatus string
+
+const (
+	// TxStatusBroadcast means the signed transaction was accepted by a
+	// node's mempool but has not yet been mined.
+	TxStatusBroadcast TxStatus = "broadcast"
+
+	// TxStatusMined means the transaction was included in a block but
+	// has not yet reached its confirmation threshold.
+	TxStatusMined TxStatus = "mined"
+
+	// TxStatusConfirmed means the transaction has reached (or
+	// exceeded) its cryptocurrency's confirmation threshold.
+	TxStatusConfirmed TxStatus = "confirmed"
+
+	// TxStatusRejected means the network rejected the transaction
+	// (e.g. it was never mined and dropped from the mempool).
+	TxStatusRejected TxStatus = "rejected"
+
+	// TxStatusDoubleSpend means a conflicting transaction spending the
+	// same inputs was confirmed instead.
+	TxStatusDoubleSpend TxStatus = "double_spend"
+)
+
+// IsTerminal reports whether status is one a TrackConfirmations loop
+// stops at.
+func (s TxStatus) IsTerminal() bool {
+	switch s {
+	case TxStatusConfirmed, TxStatusRejected, TxStatusDoubleSpend:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	thresholdMu sync.RWMutex
+	thresholds  = map[string]int{}
+)
+
+// SetConfirmationThreshold overrides the number of confirmations
+// cryptocurrency needs before a transaction is considered settled,
+// letting operators respond to a chain's changing security margin
+// without a code deploy.
+func SetConfirmationThreshold(cryptocurrency string, confirmations int) {
+	thresholdMu.Lock()
+	defer thresholdMu.Unlock()
+	thresholds[cryptocurrency] = confirmations
+}
+
+// ConfirmationThresholdFor returns the configured confirmation
+// threshold for cryptocurrency, or fallback if none has been set via
+// SetConfirmationThreshold.
+func ConfirmationThresholdFor(cryptocurrency string, fallback int) int {
+	thresholdMu.RLock()
+	defer thresholdMu.RUnlock()
+	if confirmations, ok := thresholds[cryptocurrency]; ok {
+		return confirmations
+	}
+	return fallback
+}
+
+func init() {
+	SetConfirmationThreshold("BTC", 3)
+	SetConfirmationThreshold("ETH", 12)
+	SetConfirmationThreshold("USDT", 12) // USDT-on-Tron/Ethereum; Tron finality is faster but 12 is a safe default
+	SetConfirmationThreshold("BNB", 15)
+}
@@ -0,0 +1,205 @@
+package chain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// TrackedStatus is a snapshot of what ConfirmationTracker last
+// observed for a watched transaction.
+type TrackedStatus struct {
+	Status        TxStatus
+	TxHash        string
+	BlockNumber   uint64
+	Confirmations int
+}
+
+type watchedTransaction struct {
+	tx         *compliance.Transaction
+	address    string
+	amount     money.Amount
+	recipients []string
+	last       TrackedStatus
+}
+
+// ConfirmationTracker watches pending transactions for incoming
+// on-chain settlement, polling a SettlementBackend in the background
+// and promoting each compliance.Transaction's Status (and, once
+// confirmed, CompletedAt) as confirmations accumulate. Unlike
+// Service.TrackConfirmations, which follows a transaction this system
+// itself broadcast by its known hash, ConfirmationTracker looks for a
+// payment arriving at an address it doesn't control, which may not
+// have a known hash yet.
+type ConfirmationTracker struct {
+	Backend  SettlementBackend
+	Notifier TransactionNotifier
+
+	// PollInterval is how often Run re-polls watched transactions.
+	// Defaults to defaultPollInterval if zero.
+	PollInterval time.Duration
+
+	mu      sync.Mutex
+	watched map[string]*watchedTransaction
+}
+
+// NewConfirmationTracker creates a ConfirmationTracker polling backend
+// and notifying through notifier.
+func NewConfirmationTracker(backend SettlementBackend, notifier TransactionNotifier) *ConfirmationTracker {
+	return &ConfirmationTracker{
+		Backend:      backend,
+		Notifier:     notifier,
+		PollInterval: defaultPollInterval,
+		watched:      make(map[string]*watchedTransaction),
+	}
+}
+
+// Watch registers tx for settlement tracking: Run will look for at
+// least amount arriving at address for tx.CryptoCurrency, notifying
+// every recipient as tx.Status changes, until it reaches a terminal
+// status. Call Run (typically once, in its own goroutine) to actually
+// process watched transactions.
+//
+// Calling Watch again for a tx.ID already being watched updates that
+// entry in place rather than replacing it, so a poll round already in
+// flight against the old entry can't write its result somewhere
+// Status will never look again.
+func (c *ConfirmationTracker) Watch(tx *compliance.Transaction, address string, amount money.Amount, recipients []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if w, ok := c.watched[tx.ID]; ok {
+		w.tx = tx
+		w.address = address
+		w.amount = amount
+		w.recipients = recipients
+		return
+	}
+	c.watched[tx.ID] = &watchedTransaction{
+		tx:         tx,
+		address:    address,
+		amount:     amount,
+		recipients: recipients,
+		last:       TrackedStatus{Status: TxStatus(tx.Status), TxHash: tx.TxHash},
+	}
+}
+
+// Forget stops tracking transactionID without changing its last
+// observed status.
+func (c *ConfirmationTracker) Forget(transactionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.watched, transactionID)
+}
+
+// Status returns the last snapshot ConfirmationTracker observed for
+// transactionID, and whether it is (or ever was) being watched.
+func (c *ConfirmationTracker) Status(transactionID string) (TrackedStatus, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w, ok := c.watched[transactionID]
+	if !ok {
+		return TrackedStatus{}, false
+	}
+	return w.last, true
+}
+
+// Run polls every watched transaction every PollInterval until ctx is
+// canceled. It's meant to be started once, typically as
+// `go tracker.Run(ctx)` during application startup.
+func (c *ConfirmationTracker) Run(ctx context.Context) {
+	interval := c.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	for {
+		c.pollOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// pollOnce checks every currently watched transaction once. LatestBlock
+// is fetched once for the whole cycle (rather than once per watched
+// transaction) since it doesn't vary per transaction.
+func (c *ConfirmationTracker) pollOnce(ctx context.Context) {
+	if c.Backend == nil {
+		return
+	}
+
+	c.mu.Lock()
+	pending := make([]*watchedTransaction, 0, len(c.watched))
+	for _, w := range c.watched {
+		if !TxStatus(w.tx.Status).IsTerminal() {
+			pending = append(pending, w)
+		}
+	}
+	c.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	latest, err := c.Backend.LatestBlock(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, w := range pending {
+		c.pollOne(ctx, w, latest)
+	}
+}
+
+func (c *ConfirmationTracker) pollOne(ctx context.Context, w *watchedTransaction, latest uint64) {
+	settlement, err := c.Backend.FindSettlement(ctx, w.tx.CryptoCurrency, w.address, w.amount, w.tx.TxHash)
+	if err != nil || settlement == nil {
+		// A transient RPC error or "not found yet" both just mean try
+		// again next poll; neither is fatal to the watch.
+		return
+	}
+
+	confirmations := 0
+	if latest >= settlement.BlockNumber {
+		confirmations = int(latest-settlement.BlockNumber) + 1
+	}
+
+	status := TxStatusMined
+	threshold := ConfirmationThresholdFor(w.tx.CryptoCurrency, defaultConfirmationThreshold)
+	if confirmations >= threshold {
+		status = TxStatusConfirmed
+	}
+
+	c.mu.Lock()
+	w.last = TrackedStatus{Status: status, TxHash: settlement.TxHash, BlockNumber: settlement.BlockNumber, Confirmations: confirmations}
+	statusChanged := string(status) != w.tx.Status
+	if statusChanged {
+		w.tx.Status = string(status)
+		w.tx.TxHash = settlement.TxHash
+		if status == TxStatusConfirmed {
+			w.tx.CompletedAt = time.Now()
+			delete(c.watched, w.tx.ID)
+		}
+	}
+	c.mu.Unlock()
+
+	if statusChanged {
+		c.notify(w.tx, status, w.recipients)
+	}
+}
+
+// notify tells every recipient about tx reaching status, swallowing
+// individual notification errors so one bad recipient doesn't stop the
+// tracker from updating the rest (unlike Service.notify, which a
+// single synchronous call can afford to fail outright).
+func (c *ConfirmationTracker) notify(tx *compliance.Transaction, status TxStatus, recipients []string) {
+	if c.Notifier == nil {
+		return
+	}
+	for _, recipient := range recipients {
+		c.Notifier.CreateTransactionNotification(tx.ID, string(status), recipient, tx.MerchantID)
+	}
+}
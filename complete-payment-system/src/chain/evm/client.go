@@ -0,0 +1,287 @@
+// Package evm implements chain.SettlementBackend against an Ethereum
+// (or other EVM-compatible) JSON-RPC endpoint, so ConfirmationTracker
+// can confirm an incoming payment without this system ever holding a
+// full node. It covers two shapes of transfer:
+//
+//   - ERC-20 tokens (USDT, ...): eth_getLogs decodes Transfer(address,
+//     address, uint256) log entries emitted by the token's contract.
+//   - native ETH: eth_getTransactionByHash/eth_getTransactionReceipt
+//     confirm a specific, already-known transaction hash, since a
+//     plain value transfer emits no log eth_getLogs could find.
+package evm
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/chain"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// transferEventTopic is keccak256("Transfer(address,address,uint256)"),
+// the topic0 every ERC-20 Transfer log carries.
+const transferEventTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// defaultLookbackBlocks bounds how far back eth_getLogs scans when
+// looking for an ERC-20 Transfer, so a payment is found within a
+// reasonable number of blocks without scanning from genesis.
+const defaultLookbackBlocks = 5760 // ~1 day at 15s/block
+
+// Client is a chain.SettlementBackend backed by a single EVM JSON-RPC
+// endpoint.
+type Client struct {
+	RPCURL     string
+	HTTPClient *http.Client
+
+	// LookbackBlocks bounds how far back FindSettlement scans via
+	// eth_getLogs. Defaults to defaultLookbackBlocks if zero.
+	LookbackBlocks uint64
+
+	mu             sync.RWMutex
+	tokenContracts map[string]string // cryptocurrency -> ERC-20 contract address
+}
+
+// NewClient creates a Client against rpcURL.
+func NewClient(rpcURL string) *Client {
+	return &Client{
+		RPCURL:         rpcURL,
+		HTTPClient:     http.DefaultClient,
+		tokenContracts: make(map[string]string),
+	}
+}
+
+// SetTokenContract registers contractAddress as the ERC-20 contract
+// FindSettlement should scan Transfer logs from for cryptocurrency.
+// A cryptocurrency with no registered contract is treated as a native
+// (non-token) asset.
+func (c *Client) SetTokenContract(cryptocurrency, contractAddress string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokenContracts[cryptocurrency] = contractAddress
+}
+
+func (c *Client) tokenContract(cryptocurrency string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	addr, ok := c.tokenContracts[cryptocurrency]
+	return addr, ok
+}
+
+// LatestBlock implements chain.SettlementBackend via eth_blockNumber.
+func (c *Client) LatestBlock(ctx context.Context) (uint64, error) {
+	var result string
+	if err := c.call(ctx, "eth_blockNumber", []interface{}{}, &result); err != nil {
+		return 0, fmt.Errorf("evm: eth_blockNumber: %w", err)
+	}
+	return parseHexUint64(result)
+}
+
+// FindSettlement implements chain.SettlementBackend.
+func (c *Client) FindSettlement(ctx context.Context, cryptocurrency, address string, amount money.Amount, knownTxHash string) (*chain.Settlement, error) {
+	if contract, ok := c.tokenContract(cryptocurrency); ok {
+		return c.findERC20Transfer(ctx, contract, address, amount)
+	}
+	if knownTxHash == "" {
+		return nil, nil
+	}
+	return c.findNativeTransfer(ctx, knownTxHash, address, amount)
+}
+
+// findNativeTransfer confirms a plain ETH value transfer by its
+// already-known transaction hash via eth_getTransactionByHash (to read
+// "to" and "value") and eth_getTransactionReceipt (to confirm it was
+// mined and read its block number).
+func (c *Client) findNativeTransfer(ctx context.Context, txHash, address string, amount money.Amount) (*chain.Settlement, error) {
+	var tx struct {
+		To    string `json:"to"`
+		Value string `json:"value"`
+	}
+	if err := c.call(ctx, "eth_getTransactionByHash", []interface{}{txHash}, &tx); err != nil {
+		return nil, fmt.Errorf("evm: eth_getTransactionByHash: %w", err)
+	}
+	if tx.To == "" {
+		return nil, nil // not found (yet), or a contract-creation transaction
+	}
+	if !strings.EqualFold(tx.To, address) {
+		return nil, nil
+	}
+
+	value, err := parseHexBigInt(tx.Value)
+	if err != nil {
+		return nil, fmt.Errorf("evm: parsing transaction value: %w", err)
+	}
+	if value.Cmp(amount.MinorUnits()) < 0 {
+		return nil, nil
+	}
+
+	var receipt struct {
+		BlockNumber string `json:"blockNumber"`
+		Status      string `json:"status"`
+	}
+	if err := c.call(ctx, "eth_getTransactionReceipt", []interface{}{txHash}, &receipt); err != nil {
+		return nil, fmt.Errorf("evm: eth_getTransactionReceipt: %w", err)
+	}
+	if receipt.BlockNumber == "" {
+		return nil, nil // mined but receipt not yet available, or still pending
+	}
+	if receipt.Status == "0x0" {
+		return nil, fmt.Errorf("evm: transaction %s reverted", txHash)
+	}
+
+	blockNumber, err := parseHexUint64(receipt.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("evm: parsing receipt block number: %w", err)
+	}
+	return &chain.Settlement{TxHash: txHash, BlockNumber: blockNumber}, nil
+}
+
+// findERC20Transfer scans contract's Transfer logs for one crediting
+// address with at least amount, via eth_getLogs.
+func (c *Client) findERC20Transfer(ctx context.Context, contract, address string, amount money.Amount) (*chain.Settlement, error) {
+	latest, err := c.LatestBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lookback := c.LookbackBlocks
+	if lookback == 0 {
+		lookback = defaultLookbackBlocks
+	}
+	var fromBlock uint64
+	if latest > lookback {
+		fromBlock = latest - lookback
+	}
+
+	filter := map[string]interface{}{
+		"fromBlock": toHex(fromBlock),
+		"toBlock":   "latest",
+		"address":   contract,
+		"topics":    []interface{}{transferEventTopic, nil, addressTopic(address)},
+	}
+	var logs []struct {
+		Topics      []string `json:"topics"`
+		Data        string   `json:"data"`
+		TxHash      string   `json:"transactionHash"`
+		BlockNumber string   `json:"blockNumber"`
+	}
+	if err := c.call(ctx, "eth_getLogs", []interface{}{filter}, &logs); err != nil {
+		return nil, fmt.Errorf("evm: eth_getLogs: %w", err)
+	}
+
+	for _, logEntry := range logs {
+		if len(logEntry.Topics) != 3 {
+			continue
+		}
+		value, err := parseHexBigInt(logEntry.Data)
+		if err != nil {
+			continue
+		}
+		if value.Cmp(amount.MinorUnits()) < 0 {
+			continue
+		}
+		blockNumber, err := parseHexUint64(logEntry.BlockNumber)
+		if err != nil {
+			continue
+		}
+		return &chain.Settlement{TxHash: logEntry.TxHash, BlockNumber: blockNumber}, nil
+	}
+	return nil, nil
+}
+
+// addressTopic left-pads address (a 20-byte hex address) to the
+// 32-byte topic width Transfer's indexed "to" parameter is logged at.
+func addressTopic(address string) string {
+	address = strings.TrimPrefix(address, "0x")
+	return "0x" + strings.Repeat("0", 64-len(address)) + strings.ToLower(address)
+}
+
+func parseHexUint64(s string) (uint64, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if s == "" {
+		return 0, nil
+	}
+	v, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return 0, fmt.Errorf("evm: malformed hex quantity %q", s)
+	}
+	return v.Uint64(), nil
+}
+
+func parseHexBigInt(s string) (*big.Int, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if s == "" {
+		return big.NewInt(0), nil
+	}
+	v, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return nil, fmt.Errorf("evm: malformed hex quantity %q", s)
+	}
+	return v, nil
+}
+
+func toHex(v uint64) string {
+	if v == 0 {
+		return "0x0"
+	}
+	return "0x" + hex.EncodeToString(big.NewInt(0).SetUint64(v).Bytes())
+}
+
+// rpcRequest/rpcResponse implement the JSON-RPC 2.0 envelope every
+// eth_* call above is wrapped in.
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// call issues method with params against c.RPCURL and unmarshals the
+// result into result.
+func (c *Client) call(ctx context.Context, method string, params []interface{}, result interface{}) error {
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.RPCURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var rpcResp rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return err
+	}
+	if rpcResp.Error != nil {
+		return fmt.Errorf("%s (code %d)", rpcResp.Error.Message, rpcResp.Error.Code)
+	}
+	if result == nil || len(rpcResp.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(rpcResp.Result, result)
+}
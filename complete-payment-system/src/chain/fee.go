@@ -0,0 +1,52 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// FeeQuoter returns the current network fee for broadcasting a
+// transaction in cryptocurrency, queried before broadcast so
+// Service.Broadcast can record the actual cost on the Transaction for
+// tax/reporting rather than an estimate.
+type FeeQuoter interface {
+	Quote(ctx context.Context, cryptocurrency string) (money.Amount, error)
+}
+
+// StaticFeeQuoter is a FeeQuoter backed by a fixed cryptocurrency ->
+// fee map, suitable for chains whose fee market isn't queried live
+// (or as a fallback while a live quoter is unavailable).
+type StaticFeeQuoter struct {
+	mu   sync.RWMutex
+	fees map[string]money.Amount
+}
+
+// NewStaticFeeQuoter creates a StaticFeeQuoter from the given mapping.
+func NewStaticFeeQuoter(fees map[string]money.Amount) *StaticFeeQuoter {
+	byCrypto := make(map[string]money.Amount, len(fees))
+	for k, v := range fees {
+		byCrypto[k] = v
+	}
+	return &StaticFeeQuoter{fees: byCrypto}
+}
+
+// SetFee updates the quoted fee for cryptocurrency.
+func (q *StaticFeeQuoter) SetFee(cryptocurrency string, fee money.Amount) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.fees[cryptocurrency] = fee
+}
+
+// Quote implements FeeQuoter.
+func (q *StaticFeeQuoter) Quote(ctx context.Context, cryptocurrency string) (money.Amount, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	fee, ok := q.fees[cryptocurrency]
+	if !ok {
+		return money.Amount{}, fmt.Errorf("chain: no fee configured for %s", cryptocurrency)
+	}
+	return fee, nil
+}
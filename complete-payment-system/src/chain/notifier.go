@@ -0,0 +1,11 @@
+package chain
+
+// TransactionNotifier is the subset of notification.NotificationService
+// Service needs to tell merchants/customers about a status transition.
+// Defining it here (instead of importing package notification) keeps
+// chain from depending on notification's storage/dispatch internals —
+// callers pass their *notification.NotificationService, which already
+// satisfies this interface.
+type TransactionNotifier interface {
+	CreateTransactionNotification(transactionID string, event string, recipient string, merchantID string) (string, error)
+}
@@ -0,0 +1,10 @@
+package chain
+
+import "context"
+
+// TxStatusQuerier reports a broadcast transaction's current on-chain
+// status and confirmation depth, by polling a node/indexer or
+// subscribing to one, depending on the implementation.
+type TxStatusQuerier interface {
+	QueryStatus(ctx context.Context, cryptocurrency, txHash string) (status TxStatus, confirmations int, err error)
+}
@@ -0,0 +1,142 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+)
+
+// defaultPollInterval is how often TrackConfirmations re-queries a
+// transaction's status when PollInterval isn't set.
+const defaultPollInterval = 10 * time.Second
+
+// defaultConfirmationThreshold applies to a cryptocurrency with no
+// configured SetConfirmationThreshold.
+const defaultConfirmationThreshold = 6
+
+// Service broadcasts a validated compliance.Transaction, quoting its
+// network fee first, then tracks its confirmation depth and notifies
+// merchants/customers at each milestone.
+type Service struct {
+	Broadcaster Broadcaster
+	Querier     TxStatusQuerier
+	FeeQuoter   FeeQuoter
+	Notifier    TransactionNotifier
+
+	// PollInterval is how often TrackConfirmations re-queries a
+	// transaction's status. Defaults to defaultPollInterval if zero.
+	PollInterval time.Duration
+}
+
+// NewService creates a Service that broadcasts through broadcaster and
+// tracks confirmations through querier, notifying through notifier.
+// FeeQuoter is optional: leave it nil to skip fee quoting and recording.
+func NewService(broadcaster Broadcaster, querier TxStatusQuerier, notifier TransactionNotifier) *Service {
+	return &Service{
+		Broadcaster:  broadcaster,
+		Querier:      querier,
+		Notifier:     notifier,
+		PollInterval: defaultPollInterval,
+	}
+}
+
+// Broadcast quotes the current network fee (if s.FeeQuoter is
+// configured) and records it on tx, submits rawTx via s.Broadcaster,
+// records the resulting hash and "broadcast" status on tx, and
+// notifies every recipient.
+func (s *Service) Broadcast(ctx context.Context, tx *compliance.Transaction, rawTx []byte, recipients []string) (string, error) {
+	if s.Broadcaster == nil {
+		return "", fmt.Errorf("chain: no broadcaster configured")
+	}
+
+	if s.FeeQuoter != nil {
+		fee, err := s.FeeQuoter.Quote(ctx, tx.CryptoCurrency)
+		if err != nil {
+			return "", fmt.Errorf("chain: quoting fee for %s: %w", tx.CryptoCurrency, err)
+		}
+		tx.NetworkFee = &fee
+	}
+
+	txHash, err := s.Broadcaster.Broadcast(ctx, tx.CryptoCurrency, rawTx)
+	if err != nil {
+		return "", fmt.Errorf("chain: broadcasting %s transaction %s: %w", tx.CryptoCurrency, tx.ID, err)
+	}
+
+	tx.TxHash = txHash
+	tx.Status = string(TxStatusBroadcast)
+
+	if err := s.notify(tx, TxStatusBroadcast, recipients); err != nil {
+		return txHash, err
+	}
+	return txHash, nil
+}
+
+// TrackConfirmations polls s.Querier for tx's status until it reaches
+// a terminal one (confirmed, rejected, or double-spent), notifying
+// recipients on every status transition along the way. It blocks until
+// a terminal status is reached or ctx is canceled.
+func (s *Service) TrackConfirmations(ctx context.Context, tx *compliance.Transaction, recipients []string) error {
+	if s.Querier == nil {
+		return fmt.Errorf("chain: no status querier configured")
+	}
+	if tx.TxHash == "" {
+		return fmt.Errorf("chain: transaction %s has not been broadcast", tx.ID)
+	}
+
+	pollInterval := s.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	// Seed lastStatus from tx's current status (set by Broadcast) so the
+	// first poll doesn't re-notify recipients of a milestone they were
+	// already told about.
+	lastStatus := TxStatus(tx.Status)
+	for {
+		status, confirmations, err := s.Querier.QueryStatus(ctx, tx.CryptoCurrency, tx.TxHash)
+		if err != nil {
+			return fmt.Errorf("chain: querying status for %s: %w", tx.TxHash, err)
+		}
+
+		effective := status
+		if status == TxStatusMined {
+			threshold := ConfirmationThresholdFor(tx.CryptoCurrency, defaultConfirmationThreshold)
+			if confirmations >= threshold {
+				effective = TxStatusConfirmed
+			}
+		}
+
+		if effective != lastStatus {
+			tx.Status = string(effective)
+			if err := s.notify(tx, effective, recipients); err != nil {
+				return err
+			}
+			lastStatus = effective
+		}
+
+		if effective.IsTerminal() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// notify tells every recipient about tx reaching status.
+func (s *Service) notify(tx *compliance.Transaction, status TxStatus, recipients []string) error {
+	if s.Notifier == nil {
+		return fmt.Errorf("chain: no notifier configured")
+	}
+	for _, recipient := range recipients {
+		if _, err := s.Notifier.CreateTransactionNotification(tx.ID, string(status), recipient, tx.MerchantID); err != nil {
+			return fmt.Errorf("chain: notifying %s of %s for %s: %w", recipient, status, tx.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// Settlement describes an on-chain transfer a SettlementBackend found
+// while looking for an expected incoming payment.
+type Settlement struct {
+	TxHash      string
+	BlockNumber uint64
+}
+
+// SettlementBackend confirms that an expected payment has arrived at
+// an on-chain address, abstracting over how each chain actually
+// exposes that (log-decoding JSON-RPC calls for an EVM chain, an
+// address-subscription API for a UTXO chain via Electrum, ...) so
+// ConfirmationTracker can watch any of them the same way.
+type SettlementBackend interface {
+	// LatestBlock returns the backend's current chain height, used to
+	// compute a found Settlement's confirmation depth.
+	LatestBlock(ctx context.Context) (uint64, error)
+
+	// FindSettlement looks for a transfer of at least amount to
+	// address for cryptocurrency, returning its details or nil if none
+	// has arrived yet. knownTxHash is an optional hint (e.g. a hash
+	// the customer's wallet already reported) a backend may use
+	// instead of scanning when scanning by address alone isn't
+	// possible for that cryptocurrency.
+	FindSettlement(ctx context.Context, cryptocurrency, address string, amount money.Amount, knownTxHash string) (*Settlement, error)
+}
@@ -0,0 +1,759 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/chain"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/core/exchange"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/core/notification"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/core/security"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/core/wallet"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/stablecoin"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+
+	// Country compliance modules self-register with package compliance
+	// from their init() funcs (see compliance.Register); importing them
+	// here for their side effect only is what actually wires them into
+	// the registry handleCreatePayment/handleComplianceInfo consult.
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/brunei"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/cambodia"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/indonesia"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/japan"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/laos"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/malaysia"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/philippines"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/singapore"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/southkorea"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/thailand"
+	_ "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/country/vietnam"
+)
+
+// PaymentGateway is the main entry point for the payment system
+type PaymentGateway struct {
+	PaymentProcessor    interface{} // Would be the actual payment processor in production
+	WalletService       *wallet.WalletService
+	ExchangeService     *exchange.ExchangeRateService
+	SecurityService     *security.SecurityService
+	NotificationService *notification.NotificationService
+
+	// ChainWatcher, if set, tracks on-chain settlement of payments
+	// awaiting confirmation; handleGetPayment consults it for live
+	// status/block/confirmation data instead of a placeholder.
+	ChainWatcher *chain.ConfirmationTracker
+
+	// StablecoinCache, if set, holds the reserve-attestation status
+	// country modules (e.g. CambodiaModule) check before accepting a
+	// Group 1b stablecoin transaction; handleGetStablecoinStatus
+	// exposes it over HTTP.
+	StablecoinCache *stablecoin.Cache
+
+	// TravelRule, if set, discovers the beneficiary VASP for a payment
+	// destination and exchanges a FATF Travel Rule (Recommendation 16)
+	// handshake with them; handleCreatePayment consults it to gate
+	// payments at or above the destination country's threshold.
+	TravelRule *travelrule.Service
+
+	// OriginatingVASP identifies this payment gateway's own VASP in
+	// outbound Travel Rule messages.
+	OriginatingVASP ivms101.VASP
+}
+
+// NewPaymentGateway creates a new instance of PaymentGateway.
+func NewPaymentGateway(securityService *security.SecurityService, exchangeService *exchange.ExchangeRateService, chainWatcher *chain.ConfirmationTracker, stablecoinCache *stablecoin.Cache, travelRule *travelrule.Service, originatingVASP ivms101.VASP) *PaymentGateway {
+	return &PaymentGateway{
+		// In a real implementation, we would initialize the remaining services
+		SecurityService: securityService,
+		ExchangeService: exchangeService,
+		ChainWatcher:    chainWatcher,
+		StablecoinCache: stablecoinCache,
+		TravelRule:      travelRule,
+		OriginatingVASP: originatingVASP,
+	}
+}
+
+// contextKey is an unexported type for context.WithValue keys, so this
+// package's keys can't collide with another package's.
+type contextKey string
+
+const merchantIDContextKey contextKey = "merchant_id"
+
+// requireAuth wraps next so it only runs once the request's
+// "Authorization: Bearer <token>" header carries a valid, unrevoked
+// JWT. On success the token's "sub" claim (the merchant ID) is
+// injected into the request context for next to read.
+func (g *PaymentGateway) requireAuth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if g.SecurityService == nil {
+			http.Error(w, "Authentication not configured", http.StatusInternalServerError)
+			return
+		}
+
+		const prefix = "Bearer "
+		header := r.Header.Get("Authorization")
+		if !strings.HasPrefix(header, prefix) {
+			http.Error(w, "Missing bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		valid, claims, err := g.SecurityService.VerifyJWT(strings.TrimPrefix(header, prefix))
+		if err != nil || !valid {
+			http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+			return
+		}
+
+		merchantID, _ := claims["sub"].(string)
+		ctx := context.WithValue(r.Context(), merchantIDContextKey, merchantID)
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// PaymentRequest represents a request to create a new payment
+type PaymentRequest struct {
+	// Amount is the fiat amount as an exact decimal string (e.g. "100.50"),
+	// not a float64, so high-magnitude IDR/VND values and low-magnitude
+	// satoshi amounts don't lose precision in transit.
+	Amount         string                 `json:"amount"`
+	Currency       string                 `json:"currency"`
+	CryptoCurrency string                 `json:"crypto_currency"`
+	MerchantID     string                 `json:"merchant_id"`
+	CountryCode    string                 `json:"country_code"`
+	CallbackURL    string                 `json:"callback_url,omitempty"`
+	CustomerEmail  string                 `json:"customer_email,omitempty"`
+	CustomerPhone  string                 `json:"customer_phone,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+
+	// TravelRule carries FATF Travel Rule (Recommendation 16)
+	// originator/beneficiary identity. Required once Amount reaches
+	// the destination country's Travel Rule threshold (see
+	// handleCreatePayment); omit it for smaller payments.
+	TravelRule *TravelRuleInfo `json:"travel_rule,omitempty"`
+}
+
+// TravelRuleInfo is the originator/beneficiary identity a PaymentRequest
+// must carry once its amount reaches the destination country's Travel
+// Rule threshold. Originator and Beneficiary are plain IVMS 101
+// persons so handleCreatePayment can hand them straight to
+// travelrule.GenerateMessage without reshaping them.
+type TravelRuleInfo struct {
+	Originator  ivms101.Person `json:"originator"`
+	Beneficiary ivms101.Person `json:"beneficiary"`
+}
+
+// PaymentResponse represents the response to a payment request
+type PaymentResponse struct {
+	Success        bool         `json:"success"`
+	TransactionID  string       `json:"transaction_id,omitempty"`
+	PaymentURL     string       `json:"payment_url,omitempty"`
+	PaymentAddress string       `json:"payment_address,omitempty"`
+	Amount         money.Amount `json:"amount,omitempty"`
+	CryptoAmount   money.Amount `json:"crypto_amount,omitempty"`
+	Currency       string       `json:"currency,omitempty"`
+	CryptoCurrency string       `json:"crypto_currency,omitempty"`
+	ExchangeRate   string       `json:"exchange_rate,omitempty"`
+	ExpiresAt      time.Time    `json:"expires_at,omitempty"`
+
+	// TravelRuleStatus reports whether a Travel Rule handshake with
+	// the beneficiary VASP was required for this payment and, if so,
+	// how it went: "not_required" or "completed". A failed handshake
+	// is never reported here — handleCreatePayment rejects the
+	// payment outright instead (see its TravelRule gating).
+	TravelRuleStatus string `json:"travel_rule_status,omitempty"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// TransactionStatusResponse represents the response to a transaction status request
+type TransactionStatusResponse struct {
+	Success        bool         `json:"success"`
+	TransactionID  string       `json:"transaction_id,omitempty"`
+	Status         string       `json:"status,omitempty"`
+	Amount         money.Amount `json:"amount,omitempty"`
+	CryptoAmount   money.Amount `json:"crypto_amount,omitempty"`
+	Currency       string       `json:"currency,omitempty"`
+	CryptoCurrency string       `json:"crypto_currency,omitempty"`
+	CompletedAt    time.Time    `json:"completed_at,omitempty"`
+	BlockNumber    uint64       `json:"block_number"`
+	Confirmations  int          `json:"confirmations"`
+	Error          string       `json:"error,omitempty"`
+}
+
+// StartServer starts the HTTP server for the payment gateway
+func (g *PaymentGateway) StartServer(port int) error {
+	// Set up HTTP routes
+	http.HandleFunc("/api/v1/payments", g.requireAuth(g.handleCreatePayment))
+	http.HandleFunc("/api/v1/payments/", g.requireAuth(g.handleGetPayment))
+	http.HandleFunc("/api/v1/exchange-rates", g.requireAuth(g.handleGetExchangeRates))
+	http.HandleFunc("/api/v1/auth/refresh", g.handleRefreshToken)
+	http.HandleFunc("/api/v1/stablecoin-status", g.requireAuth(g.handleGetStablecoinStatus))
+	http.HandleFunc("/api/v1/compliance/", g.requireAuth(g.handleComplianceInfo))
+
+	// Start the server
+	addr := fmt.Sprintf("0.0.0.0:%d", port)
+	log.Printf("Starting server on %s", addr)
+	return http.ListenAndServe(addr, nil)
+}
+
+// handleCreatePayment handles requests to create a new payment
+func (g *PaymentGateway) handleCreatePayment(w http.ResponseWriter, r *http.Request) {
+	// Only allow POST requests
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Parse the request body
+	var req PaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate the request
+	if req.Amount == "" || req.Currency == "" || req.CryptoCurrency == "" || req.MerchantID == "" || req.CountryCode == "" {
+		http.Error(w, "Missing required fields", http.StatusBadRequest)
+		return
+	}
+
+	module, ok := compliance.For(req.CountryCode)
+	if !ok {
+		http.Error(w, "No compliance module registered for country_code", http.StatusBadRequest)
+		return
+	}
+
+	fiat, ok := money.GetCurrency(req.Currency)
+	if !ok {
+		http.Error(w, "Unsupported currency", http.StatusBadRequest)
+		return
+	}
+
+	amount, err := money.Parse(req.Amount, fiat)
+	if err != nil || amount.Sign() <= 0 {
+		http.Error(w, "Invalid amount", http.StatusBadRequest)
+		return
+	}
+
+	crypto, ok := money.GetCurrency(req.CryptoCurrency)
+	if !ok {
+		http.Error(w, "Unsupported cryptocurrency", http.StatusBadRequest)
+		return
+	}
+
+	// In a real implementation, we would:
+	// 1. Authenticate the merchant
+	// 2. Create a transaction using the payment processor
+	// 3. Generate a payment address using the wallet service
+	// 4. Calculate the exchange rate using the exchange service
+	// 5. Return the payment details
+
+	// For now, we'll return a placeholder response using an example rate
+	transactionID := "tx_" + time.Now().Format("20060102150405")
+	paymentAddress := "0x1234567890abcdef1234567890abcdef12345678"
+
+	// Gate payments at or above the destination country's Travel Rule
+	// threshold on a completed originator/beneficiary handshake with
+	// the beneficiary VASP (FATF Recommendation 16). The threshold is
+	// the lower of GetTravelRuleThreshold() (every module enforces
+	// this one; country packages can override it at runtime via
+	// travelrule.SetThreshold, e.g. Singapore's SGD 1,500) and the
+	// optional, more conservative GetKYCRequirements()["transaction_threshold"]
+	// some modules additionally publish — taking the lower of the two
+	// means neither can be bypassed by the other going stale.
+	// module.ValidateTransaction below independently re-checks the same
+	// threshold against tx.TravelRule, so this gate and that one are
+	// complementary, not redundant: this one keeps a non-compliant
+	// payment from being created at all; that one is the backstop if
+	// one somehow is.
+	//
+	// amount.Cmp requires both sides to share a currency, so a
+	// threshold only counts when it's denominated in the same currency
+	// as the payment; a cross-currency payment (e.g. a USD payment
+	// against Cambodia's KHR threshold) would need a fiat conversion
+	// this handler doesn't yet have access to (see
+	// handleGetExchangeRates) and so isn't gated on that threshold.
+	travelRuleStatus := "not_required"
+	var travelRuleMsg *ivms101.Message
+	var travelRuleCounterparty ivms101.CounterpartyVASP
+	sendTravelRule := false
+
+	threshold := module.GetTravelRuleThreshold()
+	if raw, ok := module.GetKYCRequirements()["transaction_threshold"]; ok {
+		if kycThreshold, ok := raw.(money.Amount); ok {
+			if cmp, err := kycThreshold.Cmp(threshold); err == nil && cmp < 0 {
+				threshold = kycThreshold
+			}
+		}
+	}
+
+	if cmp, cmpErr := amount.Cmp(threshold); cmpErr == nil && cmp >= 0 {
+		if req.TravelRule == nil {
+			http.Error(w, "Travel Rule originator/beneficiary information is required for this amount", http.StatusBadRequest)
+			return
+		}
+		if g.TravelRule == nil || g.TravelRule.Directory == nil || g.TravelRule.Transport == nil {
+			http.Error(w, "Travel Rule handshake is not configured", http.StatusServiceUnavailable)
+			return
+		}
+
+		counterparty, ok := g.TravelRule.Directory.Discover(paymentAddress)
+		if !ok {
+			http.Error(w, "No beneficiary VASP found for the destination address", http.StatusBadRequest)
+			return
+		}
+
+		msg := travelrule.GenerateMessage(
+			&compliance.Transaction{SourceAddress: req.MerchantID, DestinationAddress: paymentAddress, CountryCode: req.CountryCode},
+			req.TravelRule.Originator,
+			req.TravelRule.Beneficiary,
+			g.OriginatingVASP,
+			// A real deployment would source the beneficiary
+			// VASP's legal identity from its own KYC record,
+			// not just its connection details.
+			ivms101.VASP{VASPIdentifier: counterparty.Name},
+		)
+
+		if err := travelrule.ValidateMessage(msg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Hold off on actually sending the handshake until after
+		// module.ValidateTransaction below has accepted the
+		// transaction: the beneficiary VASP shouldn't receive the
+		// originator/beneficiary identity payload for a payment
+		// that's about to be rejected on its own merits (license,
+		// limits, unsupported cryptocurrency).
+		travelRuleMsg = &msg
+		travelRuleCounterparty = counterparty
+		sendTravelRule = true
+	}
+
+	// Hand the assembled transaction to the destination country's
+	// compliance module for its own licensing/limit/cryptocurrency/
+	// Travel Rule checks before a payment URL is ever issued or the
+	// Travel Rule handshake (if any) is sent.
+	tx := &compliance.Transaction{
+		ID:                 transactionID,
+		Amount:             amount,
+		CryptoCurrency:     req.CryptoCurrency,
+		SourceAddress:      req.MerchantID,
+		DestinationAddress: paymentAddress,
+		Status:             "pending",
+		MerchantID:         req.MerchantID,
+		CountryCode:        req.CountryCode,
+		TravelRule:         travelRuleMsg,
+	}
+
+	valid, err := module.ValidateTransaction(tx)
+	if errors.Is(err, money.ErrCurrencyMismatch) {
+		// Country modules compare tx.Amount directly against
+		// limits/thresholds denominated in their own local currency
+		// (see e.g. country/malaysia.go), so a payment quoted in a
+		// different currency can't be checked against them without a
+		// fiat conversion this handler doesn't have access to (the
+		// same limitation documented above for the Travel Rule
+		// threshold) — treat it as non-blocking rather than
+		// rejecting an otherwise legitimate payment.
+		valid, err = true, nil
+	}
+	if err != nil || !valid {
+		errMsg := "transaction does not comply with the destination country's regulations"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(PaymentResponse{Success: false, Error: errMsg})
+		return
+	}
+
+	if sendTravelRule {
+		// Send directly over the already-resolved counterparty
+		// rather than g.TravelRule.Send, which would discover it
+		// again and risk resolving a different VASP than the one
+		// whose identity went into travelRuleMsg above.
+		if err := g.TravelRule.Transport.Send(r.Context(), travelRuleCounterparty, *travelRuleMsg); err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadGateway)
+			json.NewEncoder(w).Encode(PaymentResponse{Success: false, Error: "Travel Rule handshake with the beneficiary VASP failed: " + err.Error()})
+			return
+		}
+		travelRuleStatus = "completed"
+	}
+
+	// Register the transaction for on-chain settlement tracking so
+	// ChainWatcher's ConfirmationTracker notifies CallbackURL (via
+	// NotificationService.CreateTransactionNotification) as it
+	// transitions through broadcast/mined/confirmed/rejected. Both are
+	// optional: a gateway run without a configured ChainWatcher (or a
+	// request without a CallbackURL) still creates the payment, it
+	// just has nothing watching for settlement.
+	if g.ChainWatcher != nil && req.CallbackURL != "" {
+		g.ChainWatcher.Watch(tx, paymentAddress, amount, []string{req.CallbackURL})
+	}
+
+	rate := big.NewRat(1, 10000)
+	if g.ExchangeService != nil {
+		rateDecimal, _, _, _, err := g.ExchangeService.GetRateQuote(r.Context(), req.Currency, req.CryptoCurrency)
+		if err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadGateway)
+			json.NewEncoder(w).Encode(PaymentResponse{Success: false, Error: "failed to fetch exchange rate: " + err.Error()})
+			return
+		}
+		if parsed, ok := new(big.Rat).SetString(rateDecimal); ok {
+			rate = parsed
+		}
+	}
+
+	resp := PaymentResponse{
+		Success:          true,
+		TransactionID:    transactionID,
+		PaymentURL:       "https://pay.example.com/" + transactionID,
+		PaymentAddress:   paymentAddress,
+		Amount:           amount,
+		CryptoAmount:     money.FromMinorRat(crypto, new(big.Rat).Mul(new(big.Rat).SetInt(amount.MinorUnits()), rate)),
+		Currency:         req.Currency,
+		CryptoCurrency:   req.CryptoCurrency,
+		ExchangeRate:     rate.FloatString(8),
+		ExpiresAt:        time.Now().Add(1 * time.Hour),
+		TravelRuleStatus: travelRuleStatus,
+	}
+
+	// Return the response as JSON
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// handleGetPayment handles requests to get payment status
+func (g *PaymentGateway) handleGetPayment(w http.ResponseWriter, r *http.Request) {
+	// URL format: /api/v1/payments/{transaction_id}[/webhooks/replay]
+	// Both routes share the "/api/v1/payments/" registration (see
+	// StartServer) since net/http's default mux can't register a
+	// wildcard segment in the middle of a path; dispatch on the suffix
+	// instead, the same way handleComplianceInfo splits its own path.
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1/payments/")
+	if transactionID, ok := strings.CutSuffix(path, "/webhooks/replay"); ok {
+		g.handleReplayWebhooks(w, r, transactionID)
+		return
+	}
+
+	// Only allow GET requests
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Extract the transaction ID from the URL
+	transactionID := path
+	if transactionID == "" {
+		http.Error(w, "Missing transaction ID", http.StatusBadRequest)
+		return
+	}
+
+	// In a real implementation, we would:
+	// 1. Authenticate the request
+	// 2. Retrieve the transaction using the payment processor
+	// 3. Return the transaction status
+
+	// For now, we'll return a placeholder response
+	usd := money.MustGetCurrency("USD")
+	btc := money.MustGetCurrency("BTC")
+	resp := TransactionStatusResponse{
+		Success:        true,
+		TransactionID:  transactionID,
+		Status:         "pending",
+		Amount:         money.NewFromMinorInt64(usd, 100_00),
+		CryptoAmount:   money.NewFromMinorInt64(btc, 1_000_000), // 0.01 BTC
+		Currency:       "USD",
+		CryptoCurrency: "BTC",
+	}
+
+	// If a ChainWatcher is tracking this transaction's on-chain
+	// settlement, its live status/block/confirmation data supersedes
+	// the placeholder above.
+	if g.ChainWatcher != nil {
+		if tracked, ok := g.ChainWatcher.Status(transactionID); ok {
+			resp.Status = string(tracked.Status)
+			resp.BlockNumber = tracked.BlockNumber
+			resp.Confirmations = tracked.Confirmations
+		}
+	}
+
+	// Return the response as JSON
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// WebhookReplayResponse is the response to a POST
+// /api/v1/payments/{id}/webhooks/replay request.
+type WebhookReplayResponse struct {
+	Success       bool   `json:"success"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	Requeued      int    `json:"requeued,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// handleReplayWebhooks resets every webhook delivery recorded for
+// transactionID back to pending, so NotificationService's Dispatcher
+// resends them — for a merchant whose endpoint missed or never
+// received the original delivery. Scoped to the authenticated
+// merchant's own transactions: requireAuth's merchantIDContextKey is
+// passed straight to ReplayWebhook, which only replays notifications
+// recorded against that same merchant (see
+// notification.NotificationService.CreateTransactionNotification), so
+// one merchant can't trigger delivery storms against another's
+// CallbackURL by guessing transaction IDs.
+func (g *PaymentGateway) handleReplayWebhooks(w http.ResponseWriter, r *http.Request, transactionID string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if transactionID == "" {
+		http.Error(w, "Missing transaction ID", http.StatusBadRequest)
+		return
+	}
+
+	if g.NotificationService == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(WebhookReplayResponse{Success: false, Error: "notification service is not configured"})
+		return
+	}
+
+	merchantID, _ := r.Context().Value(merchantIDContextKey).(string)
+	requeued, err := g.NotificationService.ReplayWebhook(transactionID, merchantID)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(WebhookReplayResponse{Success: false, TransactionID: transactionID, Error: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(WebhookReplayResponse{Success: true, TransactionID: transactionID, Requeued: requeued})
+}
+
+// handleGetExchangeRates handles requests to get exchange rates
+func (g *PaymentGateway) handleGetExchangeRates(w http.ResponseWriter, r *http.Request) {
+	// Only allow GET requests
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Extract query parameters
+	fiatCurrency := r.URL.Query().Get("fiat")
+	cryptoCurrency := r.URL.Query().Get("crypto")
+
+	type ExchangeRateResponse struct {
+		Success        bool   `json:"success"`
+		FiatCurrency   string `json:"fiat_currency,omitempty"`
+		CryptoCurrency string `json:"crypto_currency,omitempty"`
+		Rate           string `json:"rate,omitempty"`
+		LastUpdated    string `json:"last_updated,omitempty"`
+		VenueCount     int    `json:"venue_count,omitempty"`
+		StdDev         string `json:"std_dev,omitempty"`
+		Error          string `json:"error,omitempty"`
+	}
+
+	if fiatCurrency == "" || cryptoCurrency == "" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ExchangeRateResponse{Success: false, Error: "fiat and crypto query parameters are required"})
+		return
+	}
+
+	if g.ExchangeService == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(ExchangeRateResponse{Success: false, Error: "exchange rate service is not configured"})
+		return
+	}
+
+	rateDecimal, lastUpdated, venueCount, stdDev, err := g.ExchangeService.GetRateQuote(r.Context(), fiatCurrency, cryptoCurrency)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadGateway)
+		json.NewEncoder(w).Encode(ExchangeRateResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	resp := ExchangeRateResponse{
+		Success:        true,
+		FiatCurrency:   fiatCurrency,
+		CryptoCurrency: cryptoCurrency,
+		Rate:           rateDecimal,
+		LastUpdated:    lastUpdated.Format(time.RFC3339),
+		VenueCount:     venueCount,
+		StdDev:         stdDev,
+	}
+
+	// Return the response as JSON
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// StablecoinStatusResponse is the response to a stablecoin-status
+// request.
+type StablecoinStatusResponse struct {
+	Success bool                              `json:"success"`
+	Assets  map[string]stablecoin.Attestation `json:"assets,omitempty"`
+	Error   string                            `json:"error,omitempty"`
+}
+
+// handleGetStablecoinStatus returns the cached reserve-attestation
+// status country modules use to verify stablecoin transactions. An
+// optional "asset" query parameter (e.g. "USDT") narrows the response
+// to a single asset.
+func (g *PaymentGateway) handleGetStablecoinStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if g.StablecoinCache == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(StablecoinStatusResponse{Success: false, Error: "stablecoin attestation cache not configured"})
+		return
+	}
+
+	assets := map[string]stablecoin.Attestation{}
+	if asset := r.URL.Query().Get("asset"); asset != "" {
+		attestation, ok := g.StablecoinCache.Get(asset)
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(StablecoinStatusResponse{Success: false, Error: fmt.Sprintf("no attestation cached for %q", asset)})
+			return
+		}
+		assets[asset] = attestation
+	} else {
+		assets = g.StablecoinCache.All()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(StablecoinStatusResponse{Success: true, Assets: assets})
+}
+
+// ComplianceInfoResponse is the response to a
+// /api/v1/compliance/{country_code}/{limits|kyc} request.
+type ComplianceInfoResponse struct {
+	Success     bool                    `json:"success"`
+	CountryCode string                  `json:"country_code,omitempty"`
+	Limits      map[string]money.Amount `json:"limits,omitempty"`
+	KYC         map[string]interface{}  `json:"kyc_requirements,omitempty"`
+	Error       string                  `json:"error,omitempty"`
+}
+
+// handleComplianceInfo serves a registered country module's transaction
+// limits or KYC requirements, letting an integration discover a
+// country's rules without hardcoding them.
+// URL format: /api/v1/compliance/{country_code}/{limits|kyc}
+func (g *PaymentGateway) handleComplianceInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1/compliance/")
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.Error(w, "URL must be /api/v1/compliance/{country_code}/{limits|kyc}", http.StatusBadRequest)
+		return
+	}
+	countryCode, resource := parts[0], parts[1]
+
+	module, ok := compliance.For(countryCode)
+	if !ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ComplianceInfoResponse{Success: false, Error: fmt.Sprintf("no compliance module registered for country code %q", countryCode)})
+		return
+	}
+
+	resp := ComplianceInfoResponse{Success: true, CountryCode: countryCode}
+	switch resource {
+	case "limits":
+		resp.Limits = module.GetTransactionLimits()
+	case "kyc":
+		resp.KYC = module.GetKYCRequirements()
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ComplianceInfoResponse{Success: false, Error: fmt.Sprintf("unknown compliance resource %q", resource)})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// refreshedAccessTokenTTL is how long the access token handleRefreshToken
+// issues stays valid for.
+const refreshedAccessTokenTTL = 1 * time.Hour
+
+// RefreshTokenRequest is the body handleRefreshToken expects.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshTokenResponse is the response to a refresh-token request.
+type RefreshTokenResponse struct {
+	Success     bool   `json:"success"`
+	AccessToken string `json:"access_token,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// handleRefreshToken exchanges a still-valid (and not revoked) refresh
+// token for a new access token, so a merchant integration doesn't have
+// to re-authenticate from scratch every time its access token expires.
+func (g *PaymentGateway) handleRefreshToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if g.SecurityService == nil {
+		http.Error(w, "Authentication not configured", http.StatusInternalServerError)
+		return
+	}
+
+	var req RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		http.Error(w, "Missing refresh token", http.StatusBadRequest)
+		return
+	}
+
+	accessToken, err := g.SecurityService.RefreshAccessToken(req.RefreshToken, "", refreshedAccessTokenTTL)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(RefreshTokenResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(RefreshTokenResponse{Success: true, AccessToken: accessToken})
+}
+
+func main() {
+	// Create a new payment gateway
+	gateway := NewPaymentGateway(nil, nil, nil, nil, nil, ivms101.VASP{})
+
+	// Start the server
+	if err := gateway.StartServer(8080); err != nil {
+		log.Fatalf("Failed to start server: %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+// Package compliance defines the contract every country-specific
+// regulatory module implements, the canonical Transaction type they all
+// operate on, and a registry so new countries can be added by
+// registering a constructor instead of duplicating boilerplate.
+package compliance
+
+import (
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+)
+
+// Transaction is the canonical representation of a cryptocurrency
+// payment transaction, shared by every country module.
+type Transaction struct {
+	ID                 string
+	Amount             money.Amount
+	CryptoCurrency     string
+	SourceAddress      string
+	DestinationAddress string
+	Status             string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	CompletedAt        time.Time
+	MerchantID         string
+	CustomerID         string
+	CountryCode        string
+
+	// TravelRule carries the FATF Travel Rule (Recommendation 16)
+	// originator/beneficiary data required once Amount exceeds a
+	// country's travel-rule threshold. nil means no data was supplied.
+	TravelRule *ivms101.Message
+
+	// NetworkFee is the actual on-chain fee paid to broadcast this
+	// transaction, recorded once package chain confirms broadcast, for
+	// tax and reporting purposes. nil until then.
+	NetworkFee *money.Amount
+
+	// TxHash is the broadcast transaction's on-chain hash, set once
+	// package chain has broadcast it.
+	TxHash string
+}
+
+// Module is the contract every country-specific regulatory module
+// implements.
+type Module interface {
+	// ValidateTransaction checks if a transaction complies with the
+	// module's country-specific regulations. transaction is expected to
+	// be a *Transaction.
+	ValidateTransaction(transaction interface{}) (bool, error)
+
+	// GenerateReports creates regulatory reports for the period and
+	// pagination parameters described by query.
+	GenerateReports(query storage.ReportQuery) (storage.ReportPage, error)
+
+	// ListTransactions returns a cursor-paginated page of the country's
+	// transactions, optionally long-polling for a new one.
+	ListTransactions(query storage.ReportQuery) (storage.ReportPage, error)
+
+	// GetTransactionLimits returns the transaction limits for the
+	// country, keyed by period ("daily", "monthly").
+	GetTransactionLimits() map[string]money.Amount
+
+	// CalculateTax calculates any applicable taxes for a transaction.
+	CalculateTax(transaction interface{}) (money.Amount, error)
+
+	// GetTravelRuleThreshold returns the transaction amount above which
+	// FATF Travel Rule originator/beneficiary data is required.
+	GetTravelRuleThreshold() money.Amount
+
+	// ClassifyCryptoAsset returns the country's own risk/regulatory
+	// classification for cryptoCurrency (e.g. Cambodia's NBC "Group
+	// 1a"/"Group 1b"/"Group 2"), or an error if the asset isn't
+	// recognized. Each module defines its own scheme; there is no
+	// shared taxonomy across countries.
+	ClassifyCryptoAsset(cryptoCurrency string) (string, error)
+
+	GetSupportedCryptocurrencies() []string
+	GetCountryCode() string
+	GetCountryName() string
+	GetRegulators() []string
+	GetKYCRequirements() map[string]interface{}
+}
+
+// RulesConfigurable is implemented by Modules whose limits, supported
+// cryptocurrencies, KYC fields, and tax rate can be replaced at
+// runtime — e.g. from EmbeddedRules or LoadRules — without
+// constructing a new Module. Not every Module needs this; a country
+// that genuinely requires code for its regulations can leave it out.
+type RulesConfigurable interface {
+	SetRules(r Rules)
+}
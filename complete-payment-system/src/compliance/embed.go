@@ -0,0 +1,64 @@
+package compliance
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+// defaultRulesJSON is compiled into the binary so every supported
+// country has a working set of limits, KYC fields, and tax treatment
+// out of the box — operators only need to hand LoadRules an external
+// file when they want to override or add a jurisdiction, not just to
+// get started.
+//
+//go:embed default_rules.json
+var defaultRulesJSON []byte
+
+// EmbeddedRules parses and validates the rules compiled into the
+// binary, in the same map[country code]Rules shape LoadRules returns.
+func EmbeddedRules() (map[string]Rules, error) {
+	var rules map[string]Rules
+	if err := json.Unmarshal(defaultRulesJSON, &rules); err != nil {
+		return nil, fmt.Errorf("compliance: parsing embedded rules: %w", err)
+	}
+
+	for code, r := range rules {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+		if r.CountryCode != code {
+			return nil, fmt.Errorf("compliance: embedded rules entry key %q does not match its country_code %q", code, r.CountryCode)
+		}
+	}
+
+	return rules, nil
+}
+
+// LoadRulesWithFallback loads rules from path, the same as LoadRules,
+// then fills in any country present in the embedded defaults but
+// missing from path — so a partial override file only needs to list
+// the jurisdictions it changes.
+func LoadRulesWithFallback(path string) (map[string]Rules, error) {
+	defaults, err := EmbeddedRules()
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return defaults, nil
+	}
+
+	overrides, err := LoadRules(path)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]Rules, len(defaults))
+	for code, r := range defaults {
+		merged[code] = r
+	}
+	for code, r := range overrides {
+		merged[code] = r
+	}
+	return merged, nil
+}
@@ -0,0 +1,71 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// FeeBreakdown separates what a transaction owes the country's
+// regulator (Tax) from what it owes the network it settled on
+// (NetworkFee), so a FeeDistributor can route each to the right place
+// instead of a single opaque total. Tax is always in the country's
+// fiat currency; NetworkFee is in whatever currency CalculateFees was
+// given, which Total requires to already match Tax's currency.
+type FeeBreakdown struct {
+	Tax        money.Amount
+	NetworkFee money.Amount
+}
+
+// Total returns Tax plus NetworkFee, which only succeeds if both are
+// denominated in the same currency — e.g. the country's tax is in
+// SGD and the caller converted the on-chain network fee (typically
+// quoted in the settlement cryptocurrency) to SGD before calling
+// CalculateFees. If they differ, Total returns money.ErrCurrencyMismatch
+// rather than silently combining two incompatible units.
+func (b FeeBreakdown) Total() (money.Amount, error) {
+	return b.Tax.Add(b.NetworkFee)
+}
+
+// CurrencyConverter converts amount into toCurrency, e.g.
+// (*exchange.ExchangeRateService).ConvertAmount. CalculateFees takes
+// one so it stays independent of package exchange's rate-fetching
+// machinery while still being able to reconcile Tax and NetworkFee
+// into a single currency.
+type CurrencyConverter func(ctx context.Context, amount money.Amount, toCurrency string) (money.Amount, error)
+
+// CalculateFees composes module's country-specific tax calculation
+// with the network fee already charged for settlement, giving callers
+// a single breakdown to hand to a FeeDistributor rather than
+// collecting tax and network fee through separate code paths. If
+// networkFee isn't already denominated in the tax's currency, convert
+// must be supplied to reconcile them; pass a nil convert only when the
+// caller doesn't need FeeBreakdown.Total to succeed.
+func CalculateFees(ctx context.Context, module Module, transaction interface{}, networkFee money.Amount, convert CurrencyConverter) (FeeBreakdown, error) {
+	tax, err := module.CalculateTax(transaction)
+	if err != nil {
+		return FeeBreakdown{}, fmt.Errorf("compliance: calculating tax: %w", err)
+	}
+
+	if networkFee.Currency().Code != tax.Currency().Code {
+		if convert == nil {
+			return FeeBreakdown{Tax: tax, NetworkFee: networkFee}, nil
+		}
+		converted, err := convert(ctx, networkFee, tax.Currency().Code)
+		if err != nil {
+			return FeeBreakdown{}, fmt.Errorf("compliance: converting network fee to %s: %w", tax.Currency().Code, err)
+		}
+		networkFee = converted
+	}
+
+	return FeeBreakdown{Tax: tax, NetworkFee: networkFee}, nil
+}
+
+// FeeDistributor routes a FeeBreakdown to wherever each component
+// belongs — a regulator remittance account for Tax, a network fee
+// pool for NetworkFee — keeping that "piggy-bank" handling decoupled
+// from the calculation CalculateFees performs.
+type FeeDistributor interface {
+	Distribute(ctx context.Context, countryCode string, breakdown FeeBreakdown) error
+}
@@ -0,0 +1,113 @@
+package compliance
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Factory constructs a Module. Country packages register one under
+// their ISO country code in an init func, the same pattern as
+// database/sql drivers register themselves.
+type Factory func() Module
+
+// Registry resolves a country code to its Module, instantiating it
+// lazily on first use and caching the instance.
+type Registry struct {
+	mu        sync.Mutex
+	factories map[string]Factory
+	instances map[string]Module
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]Factory),
+		instances: make(map[string]Module),
+	}
+}
+
+// Register associates countryCode with factory. Re-registering a code
+// drops any cached instance so the next For call picks up the new
+// factory.
+func (r *Registry) Register(countryCode string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[countryCode] = factory
+	delete(r.instances, countryCode)
+}
+
+// For resolves countryCode to its Module, constructing it on first
+// request.
+func (r *Registry) For(countryCode string) (Module, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if m, ok := r.instances[countryCode]; ok {
+		return m, true
+	}
+
+	factory, ok := r.factories[countryCode]
+	if !ok {
+		return nil, false
+	}
+
+	m := factory()
+	r.instances[countryCode] = m
+	return m, true
+}
+
+// Route dispatches tx to the module registered for tx.CountryCode.
+func (r *Registry) Route(tx *Transaction) (bool, error) {
+	module, ok := r.For(tx.CountryCode)
+	if !ok {
+		return false, fmt.Errorf("compliance: no module registered for country code %q", tx.CountryCode)
+	}
+	return module.ValidateTransaction(tx)
+}
+
+// Configure applies rules to every already-registered country code it
+// covers whose Module implements RulesConfigurable, so a new set of
+// limits (e.g. from EmbeddedRules or LoadRules) can be rolled out to
+// every country at startup, or to a single one after a regulator
+// update, without recompiling. Codes with no registered factory, or
+// whose Module doesn't implement RulesConfigurable, are skipped.
+//
+// SetRules is not synchronized against concurrent reads of a Module's
+// Rules (the same unsynchronized-field convention Rules already used
+// before Configure existed). Call Configure during startup or a
+// maintenance window, not while the Module is serving live traffic.
+func (r *Registry) Configure(rules map[string]Rules) {
+	for code, rule := range rules {
+		module, ok := r.For(code)
+		if !ok {
+			continue
+		}
+		if configurable, ok := module.(RulesConfigurable); ok {
+			configurable.SetRules(rule)
+		}
+	}
+}
+
+// defaultRegistry is the process-wide registry that country packages
+// register themselves with from init().
+var defaultRegistry = NewRegistry()
+
+// Register adds factory to the default registry under countryCode.
+func Register(countryCode string, factory Factory) {
+	defaultRegistry.Register(countryCode, factory)
+}
+
+// For resolves countryCode against the default registry.
+func For(countryCode string) (Module, bool) {
+	return defaultRegistry.For(countryCode)
+}
+
+// Route dispatches tx against the default registry.
+func Route(tx *Transaction) (bool, error) {
+	return defaultRegistry.Route(tx)
+}
+
+// Configure applies rules against the default registry.
+func Configure(rules map[string]Rules) {
+	defaultRegistry.Configure(rules)
+}
@@ -0,0 +1,105 @@
+package compliance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// Rules holds the regulator-driven configuration for a single country
+// module: transaction limits, supported cryptocurrencies, KYC fields,
+// tax treatment, and licensing flags. Loading these from a data file
+// lets operators respond to regulatory changes without a code deploy.
+type Rules struct {
+	CountryCode               string                 `json:"country_code"`
+	Currency                  string                 `json:"currency"`
+	DailyLimit                string                 `json:"daily_limit"`
+	MonthlyLimit              string                 `json:"monthly_limit"`
+	SupportedCryptocurrencies []string               `json:"supported_cryptocurrencies"`
+	KYCRequirements           map[string]interface{} `json:"kyc_requirements"`
+	TaxRatePercent            string                 `json:"tax_rate_percent"`
+	LicenceRequired           bool                   `json:"licence_required"`
+}
+
+// requiredRuleFields lists the Rules fields every country entry must
+// supply; used to validate a loaded rule file at startup rather than
+// failing lazily the first time a module consults it.
+func (r Rules) Validate() error {
+	if r.CountryCode == "" {
+		return fmt.Errorf("compliance: rules entry missing country_code")
+	}
+	if r.Currency == "" {
+		return fmt.Errorf("compliance: rules for %q missing currency", r.CountryCode)
+	}
+	if r.DailyLimit == "" || r.MonthlyLimit == "" {
+		return fmt.Errorf("compliance: rules for %q missing daily_limit/monthly_limit", r.CountryCode)
+	}
+	if len(r.SupportedCryptocurrencies) == 0 {
+		return fmt.Errorf("compliance: rules for %q missing supported_cryptocurrencies", r.CountryCode)
+	}
+	if len(r.KYCRequirements) == 0 {
+		return fmt.Errorf("compliance: rules for %q missing kyc_requirements", r.CountryCode)
+	}
+	return nil
+}
+
+// Limits parses DailyLimit/MonthlyLimit into money.Amount values keyed
+// by period, as returned by Module.GetTransactionLimits.
+func (r Rules) Limits() (map[string]money.Amount, error) {
+	cur, ok := money.GetCurrency(r.Currency)
+	if !ok {
+		return nil, fmt.Errorf("compliance: unknown currency %q for %q", r.Currency, r.CountryCode)
+	}
+	daily, err := money.Parse(r.DailyLimit, cur)
+	if err != nil {
+		return nil, fmt.Errorf("compliance: parsing daily_limit for %q: %w", r.CountryCode, err)
+	}
+	monthly, err := money.Parse(r.MonthlyLimit, cur)
+	if err != nil {
+		return nil, fmt.Errorf("compliance: parsing monthly_limit for %q: %w", r.CountryCode, err)
+	}
+	return map[string]money.Amount{"daily": daily, "monthly": monthly}, nil
+}
+
+// TaxRate parses TaxRatePercent (e.g. "24" for 24%) into a fraction
+// suitable for Amount.Mul. An empty TaxRatePercent means no tax.
+func (r Rules) TaxRate() (*big.Rat, error) {
+	if r.TaxRatePercent == "" {
+		return big.NewRat(0, 1), nil
+	}
+	percent, ok := new(big.Rat).SetString(r.TaxRatePercent)
+	if !ok {
+		return nil, fmt.Errorf("compliance: invalid tax_rate_percent %q for %q", r.TaxRatePercent, r.CountryCode)
+	}
+	return percent.Quo(percent, big.NewRat(100, 1)), nil
+}
+
+// LoadRules reads a JSON file mapping country code to Rules and
+// validates that every entry supplies the fields a Module needs, so a
+// malformed regulator update fails at load time rather than the first
+// time a transaction hits the gap.
+func LoadRules(path string) (map[string]Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("compliance: reading rules file %s: %w", path, err)
+	}
+
+	var rules map[string]Rules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("compliance: parsing rules file %s: %w", path, err)
+	}
+
+	for code, r := range rules {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+		if r.CountryCode != code {
+			return nil, fmt.Errorf("compliance: rules entry key %q does not match its country_code %q", code, r.CountryCode)
+		}
+	}
+
+	return rules, nil
+}
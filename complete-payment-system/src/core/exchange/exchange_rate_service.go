@@ -0,0 +1,510 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// ErrStale is returned by GetExchangeRate when every provider failed and
+// the last cached rate has exceeded its MaxStaleness policy, so the caller
+// gets an explicit error instead of a silently outdated rate.
+var ErrStale = errors.New("exchange: rate is stale and no provider could refresh it")
+
+// ExchangeRate represents the conversion rate between a fiat currency and a cryptocurrency
+type ExchangeRate struct {
+	FiatCurrency   string `json:"fiat_currency"`
+	CryptoCurrency string `json:"crypto_currency"`
+	// Rate is how much CryptoCurrency one unit of FiatCurrency buys,
+	// expressed as an exact fraction rather than float64 so that spreads
+	// and conversions don't accumulate rounding error.
+	Rate        *big.Rat  `json:"-"`
+	RateDecimal string    `json:"rate"`
+	LastUpdated time.Time `json:"last_updated"`
+	Source      string    `json:"source"`
+}
+
+func newExchangeRateFromRat(fiat, crypto string, value *big.Rat, fetchedAt time.Time, source string) *ExchangeRate {
+	return &ExchangeRate{
+		FiatCurrency:   fiat,
+		CryptoCurrency: crypto,
+		Rate:           value,
+		RateDecimal:    value.FloatString(12),
+		LastUpdated:    fetchedAt,
+		Source:         source,
+	}
+}
+
+// RateMetrics holds Prometheus-style counters for the exchange rate
+// aggregator. Counters are safe for concurrent use.
+type RateMetrics struct {
+	CacheHits         int64
+	ProviderErrors    int64
+	OutlierRejections int64
+}
+
+// FormatPrometheus renders the counters in the Prometheus text exposition
+// format.
+func (m *RateMetrics) FormatPrometheus() string {
+	return fmt.Sprintf(
+		"# TYPE exchange_rate_cache_hits_total counter\nexchange_rate_cache_hits_total %d\n"+
+			"# TYPE exchange_rate_provider_errors_total counter\nexchange_rate_provider_errors_total %d\n"+
+			"# TYPE exchange_rate_outlier_rejections_total counter\nexchange_rate_outlier_rejections_total %d\n",
+		atomic.LoadInt64(&m.CacheHits),
+		atomic.LoadInt64(&m.ProviderErrors),
+		atomic.LoadInt64(&m.OutlierRejections),
+	)
+}
+
+// providerEntry pairs a RateProvider with the priority it was registered
+// with. Providers are queried together regardless of priority; priority
+// only determines iteration/display order (e.g. which provider is listed
+// first in logs).
+type providerEntry struct {
+	provider RateProvider
+	priority int
+}
+
+// ExchangeRateService handles exchange rate operations
+type ExchangeRateService struct {
+	// In-memory cache of exchange rates
+	rateCache      map[string]map[string]*ExchangeRate
+	cacheMutex     sync.RWMutex
+	updateInterval time.Duration
+
+	providersMutex sync.RWMutex
+	providers      []providerEntry
+
+	// maxStaleness holds per-pair staleness policies, keyed by "FIAT/CRYPTO".
+	// Pairs without an explicit entry fall back to defaultMaxStaleness.
+	maxStaleness        map[string]time.Duration
+	defaultMaxStaleness time.Duration
+
+	// outlierThreshold is the maximum fractional deviation from the median
+	// a quote may have before it is discarded as an outlier (e.g. 0.05 for 5%).
+	outlierThreshold *big.Rat
+
+	Metrics *RateMetrics
+
+	// The fields below back GetRate's time-weighted average: a
+	// background sampler (see StartSampling) periodically calls
+	// aggregate and appends the result to samples, and GetRate combines
+	// whatever falls within sampleWindow using MAD-based outlier
+	// rejection (see rate_twap.go).
+	samplesMutex   sync.Mutex
+	samples        map[string][]rateSample
+	sampleWindow   time.Duration
+	sampleInterval time.Duration
+	samplingMutex  sync.Mutex
+	samplingPairs  map[string]context.CancelFunc
+
+	// madK is the MAD multiplier GetRate uses to reject outlier samples
+	// (default 3, per the median-absolute-deviation rule of thumb).
+	madK *big.Rat
+
+	// ResultCache holds GetRate's aggregated results with a TTL, so
+	// concurrent callers for the same pair don't each recompute a TWAP.
+	// Defaults to an in-memory cache; set to a *RedisRateCache to share
+	// results across gateway instances.
+	ResultCache RateCache
+
+	// ResultTTL is how long a GetRate result stays valid in ResultCache.
+	ResultTTL time.Duration
+}
+
+// NewExchangeRateService creates a new instance of ExchangeRateService,
+// pre-registered with a static fallback provider plus the CoinGecko and
+// Binance live providers.
+func NewExchangeRateService() *ExchangeRateService {
+	service := &ExchangeRateService{
+		rateCache:           make(map[string]map[string]*ExchangeRate),
+		updateInterval:      5 * time.Minute,
+		maxStaleness:        make(map[string]time.Duration),
+		defaultMaxStaleness: 30 * time.Minute,
+		outlierThreshold:    big.NewRat(5, 100), // 5%
+		Metrics:             &RateMetrics{},
+
+		samples:        make(map[string][]rateSample),
+		sampleWindow:   60 * time.Second,
+		sampleInterval: 1 * time.Second,
+		samplingPairs:  make(map[string]context.CancelFunc),
+		madK:           big.NewRat(3, 1),
+		ResultCache:    NewMemoryRateCache(),
+		ResultTTL:      5 * time.Second,
+	}
+
+	service.RegisterProvider(NewStaticProvider(exampleStaticRates), 0)
+	// At least three independent venues back every live quote, each
+	// behind its own circuit breaker so one venue's outage costs a
+	// fast failure instead of stalling the whole aggregation pass.
+	service.RegisterProvider(NewCircuitBreakerSource(NewCoinGeckoProvider(3*time.Second), 3, 30*time.Second), 10)
+	service.RegisterProvider(NewCircuitBreakerSource(NewBinanceProvider(3*time.Second), 3, 30*time.Second), 10)
+	service.RegisterProvider(NewCircuitBreakerSource(NewCoinbaseProvider(3*time.Second), 3, 30*time.Second), 10)
+	service.RegisterProvider(NewCircuitBreakerSource(NewKrakenProvider(3*time.Second), 3, 30*time.Second), 10)
+
+	return service
+}
+
+// RegisterProvider adds a RateProvider to the aggregator. Higher priority
+// values sort earlier in ListProviders but do not otherwise affect
+// aggregation, which weighs every supporting provider's quote equally
+// (or by volume, when available).
+func (s *ExchangeRateService) RegisterProvider(p RateProvider, priority int) {
+	s.providersMutex.Lock()
+	defer s.providersMutex.Unlock()
+
+	s.providers = append(s.providers, providerEntry{provider: p, priority: priority})
+	sort.SliceStable(s.providers, func(i, j int) bool {
+		return s.providers[i].priority > s.providers[j].priority
+	})
+}
+
+// ListProviders returns the names of the registered providers in priority order.
+func (s *ExchangeRateService) ListProviders() []string {
+	s.providersMutex.RLock()
+	defer s.providersMutex.RUnlock()
+
+	names := make([]string, len(s.providers))
+	for i, e := range s.providers {
+		names[i] = e.provider.Name()
+	}
+	return names
+}
+
+// SetMaxStaleness sets the staleness policy for a specific fiat/crypto pair.
+func (s *ExchangeRateService) SetMaxStaleness(fiatCurrency, cryptoCurrency string, d time.Duration) {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+	s.maxStaleness[pairKey(fiatCurrency, cryptoCurrency)] = d
+}
+
+func (s *ExchangeRateService) maxStalenessFor(fiatCurrency, cryptoCurrency string) time.Duration {
+	s.cacheMutex.RLock()
+	defer s.cacheMutex.RUnlock()
+	if d, ok := s.maxStaleness[pairKey(fiatCurrency, cryptoCurrency)]; ok {
+		return d
+	}
+	return s.defaultMaxStaleness
+}
+
+func pairKey(fiatCurrency, cryptoCurrency string) string {
+	return fiatCurrency + "/" + cryptoCurrency
+}
+
+// GetExchangeRate retrieves the current exchange rate for a currency pair,
+// aggregating across all registered providers that support it. A fresh
+// cache entry is returned without querying providers. If every provider
+// fails, the last cached rate is served as long as it's within its
+// MaxStaleness policy; otherwise ErrStale is returned.
+func (s *ExchangeRateService) GetExchangeRate(ctx context.Context, fiatCurrency, cryptoCurrency string) (*ExchangeRate, error) {
+	if fiatCurrency == "" || cryptoCurrency == "" {
+		return nil, errors.New("both fiat currency and cryptocurrency must be specified")
+	}
+
+	cached := s.cacheGet(fiatCurrency, cryptoCurrency)
+	if cached != nil && time.Since(cached.LastUpdated) < s.updateInterval {
+		atomic.AddInt64(&s.Metrics.CacheHits, 1)
+		return cached, nil
+	}
+
+	aggregated, err := s.aggregate(ctx, fiatCurrency, cryptoCurrency)
+	if err != nil {
+		if cached != nil {
+			if time.Since(cached.LastUpdated) <= s.maxStalenessFor(fiatCurrency, cryptoCurrency) {
+				return cached, nil
+			}
+			return nil, ErrStale
+		}
+		return nil, err
+	}
+
+	s.cacheSet(fiatCurrency, cryptoCurrency, aggregated)
+	return aggregated, nil
+}
+
+func (s *ExchangeRateService) cacheGet(fiatCurrency, cryptoCurrency string) *ExchangeRate {
+	s.cacheMutex.RLock()
+	defer s.cacheMutex.RUnlock()
+	if fiatRates, ok := s.rateCache[fiatCurrency]; ok {
+		return fiatRates[cryptoCurrency]
+	}
+	return nil
+}
+
+func (s *ExchangeRateService) cacheSet(fiatCurrency, cryptoCurrency string, rate *ExchangeRate) {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+	if _, ok := s.rateCache[fiatCurrency]; !ok {
+		s.rateCache[fiatCurrency] = make(map[string]*ExchangeRate)
+	}
+	s.rateCache[fiatCurrency][cryptoCurrency] = rate
+}
+
+// GetAllExchangeRates retrieves all currently cached exchange rates.
+func (s *ExchangeRateService) GetAllExchangeRates() ([]*ExchangeRate, error) {
+	s.cacheMutex.RLock()
+	defer s.cacheMutex.RUnlock()
+
+	rates := make([]*ExchangeRate, 0)
+	for _, fiatRates := range s.rateCache {
+		for _, rate := range fiatRates {
+			rates = append(rates, rate)
+		}
+	}
+	return rates, nil
+}
+
+// fetchQuotes queries every provider that supports the pair in parallel
+// and returns the quotes from those that succeeded, recording a
+// ProviderErrors metric for each failure. Used both by aggregate (a
+// single cross-venue quote) and by the background sampler that feeds
+// GetRate's TWAP (see rate_twap.go).
+func (s *ExchangeRateService) fetchQuotes(ctx context.Context, fiatCurrency, cryptoCurrency string) ([]Rate, error) {
+	s.providersMutex.RLock()
+	entries := make([]providerEntry, len(s.providers))
+	copy(entries, s.providers)
+	s.providersMutex.RUnlock()
+
+	type result struct {
+		rate Rate
+		err  error
+	}
+
+	var supporting []RateProvider
+	for _, e := range entries {
+		if e.provider.Supports(fiatCurrency, cryptoCurrency) {
+			supporting = append(supporting, e.provider)
+		}
+	}
+	if len(supporting) == 0 {
+		return nil, fmt.Errorf("exchange: no provider supports %s/%s", fiatCurrency, cryptoCurrency)
+	}
+
+	results := make(chan result, len(supporting))
+	for _, p := range supporting {
+		go func(p RateProvider) {
+			rate, err := p.Fetch(ctx, fiatCurrency, cryptoCurrency)
+			results <- result{rate: rate, err: err}
+		}(p)
+	}
+
+	quotes := make([]Rate, 0, len(supporting))
+	for i := 0; i < len(supporting); i++ {
+		r := <-results
+		if r.err != nil {
+			atomic.AddInt64(&s.Metrics.ProviderErrors, 1)
+			continue
+		}
+		quotes = append(quotes, r.rate)
+	}
+
+	if len(quotes) == 0 {
+		return nil, fmt.Errorf("exchange: all providers failed for %s/%s", fiatCurrency, cryptoCurrency)
+	}
+	return quotes, nil
+}
+
+// aggregate queries every supporting provider in parallel, discards errors
+// and outliers, and returns the median (or volume-weighted average, when
+// volume is available) of the remaining quotes.
+func (s *ExchangeRateService) aggregate(ctx context.Context, fiatCurrency, cryptoCurrency string) (*ExchangeRate, error) {
+	quotes, err := s.fetchQuotes(ctx, fiatCurrency, cryptoCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	finalValue, _ := s.combineQuotes(quotes)
+	return newExchangeRateFromRat(fiatCurrency, cryptoCurrency, finalValue, time.Now(), "aggregated"), nil
+}
+
+// combineQuotes discards cross-venue outliers (quotes more than
+// outlierThreshold away from the median) and returns the volume-weighted
+// average of what's left (or its median, if none of the quotes carry
+// volume), along with how many quotes survived — the venue count behind
+// the returned rate. Shared by aggregate (a single cross-venue quote)
+// and GetRate's cold-start fallback (see rate_twap.go), so both report
+// the same venue-count semantics.
+func (s *ExchangeRateService) combineQuotes(quotes []Rate) (*big.Rat, int) {
+	values := make([]*big.Rat, len(quotes))
+	for i, q := range quotes {
+		values[i] = q.Value
+	}
+	median := medianRat(values)
+
+	filtered := make([]Rate, 0, len(quotes))
+	for _, q := range quotes {
+		if isOutlier(q.Value, median, s.outlierThreshold) {
+			atomic.AddInt64(&s.Metrics.OutlierRejections, 1)
+			continue
+		}
+		filtered = append(filtered, q)
+	}
+	if len(filtered) == 0 {
+		// Every quote looked like an outlier relative to the others; fall
+		// back to the unfiltered median rather than failing outright.
+		filtered = quotes
+	}
+
+	finalValue := volumeWeightedAverage(filtered)
+	if finalValue == nil {
+		values = values[:0]
+		for _, q := range filtered {
+			values = append(values, q.Value)
+		}
+		finalValue = medianRat(values)
+	}
+
+	return finalValue, len(filtered)
+}
+
+// medianRat returns the median of a slice of rationals, without mutating
+// the input.
+func medianRat(values []*big.Rat) *big.Rat {
+	sorted := make([]*big.Rat, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return new(big.Rat).Set(sorted[n/2])
+	}
+	sum := new(big.Rat).Add(sorted[n/2-1], sorted[n/2])
+	return sum.Quo(sum, big.NewRat(2, 1))
+}
+
+// isOutlier reports whether value deviates from median by more than
+// threshold (a fraction, e.g. 0.05 for 5%).
+func isOutlier(value, median, threshold *big.Rat) bool {
+	if median.Sign() == 0 {
+		return value.Sign() != 0
+	}
+	diff := new(big.Rat).Sub(value, median)
+	diff.Abs(diff)
+	deviation := new(big.Rat).Quo(diff, new(big.Rat).Abs(median))
+	return deviation.Cmp(threshold) > 0
+}
+
+// volumeWeightedAverage computes sum(value*volume)/sum(volume) across
+// quotes that expose a volume. Returns nil if no quote has volume data.
+func volumeWeightedAverage(quotes []Rate) *big.Rat {
+	totalVolume := new(big.Rat)
+	weightedSum := new(big.Rat)
+	found := false
+
+	for _, q := range quotes {
+		if q.Volume == nil || q.Volume.Sign() <= 0 {
+			continue
+		}
+		found = true
+		weightedSum.Add(weightedSum, new(big.Rat).Mul(q.Value, q.Volume))
+		totalVolume.Add(totalVolume, q.Volume)
+	}
+
+	if !found || totalVolume.Sign() == 0 {
+		return nil
+	}
+	return weightedSum.Quo(weightedSum, totalVolume)
+}
+
+// exampleStaticRates seed the StaticProvider fallback so the aggregator
+// always has at least one quote, even with no network access.
+var exampleStaticRates = map[string]map[string]string{
+	"USD": {
+		"BTC":  "0.000025",
+		"ETH":  "0.00035",
+		"USDT": "1.0",
+	},
+	"SGD": {
+		"BTC":  "0.000019",
+		"ETH":  "0.00026",
+		"USDT": "0.75",
+	},
+	"MYR": {
+		"BTC":  "0.0000059",
+		"ETH":  "0.000082",
+		"USDT": "0.23",
+	},
+	"THB": {
+		"BTC":  "0.00000078",
+		"ETH":  "0.000011",
+		"USDT": "0.030",
+	},
+	"IDR": {
+		"BTC":  "0.00000000165",
+		"ETH":  "0.000000023",
+		"USDT": "0.000064",
+	},
+	"VND": {
+		"BTC":  "0.00000000104",
+		"ETH":  "0.000000014",
+		"USDT": "0.000041",
+	},
+	"BND": {
+		"BTC":  "0.000019",
+		"ETH":  "0.00026",
+		"USDT": "0.75",
+	},
+	"KHR": {
+		"BTC":  "0.0000000025",
+		"ETH":  "0.000000035",
+		"USDT": "0.00025",
+	},
+	"LAK": {
+		"BTC":  "0.00000000021",
+		"ETH":  "0.0000000029",
+		"USDT": "0.000056",
+	},
+}
+
+// ConvertAmount converts amount (denominated in fromCurrency) into
+// toCurrency using the current exchange rate, rejecting amounts whose
+// currency doesn't match fromCurrency.
+func (s *ExchangeRateService) ConvertAmount(ctx context.Context, amount money.Amount, fromCurrency, toCurrency string) (money.Amount, error) {
+	if amount.Sign() < 0 {
+		return money.Amount{}, errors.New("amount cannot be negative")
+	}
+	if amount.Currency().Code != fromCurrency {
+		return money.Amount{}, fmt.Errorf("amount is denominated in %s, not %s", amount.Currency().Code, fromCurrency)
+	}
+
+	toCur, ok := money.GetCurrency(toCurrency)
+	if !ok {
+		return money.Amount{}, fmt.Errorf("unknown target currency %q", toCurrency)
+	}
+
+	rate, err := s.GetExchangeRate(ctx, fromCurrency, toCurrency)
+	if err != nil {
+		return money.Amount{}, err
+	}
+
+	// Perform the conversion at full precision, then round to the target
+	// currency's minor unit.
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt(amount.MinorUnits()), rate.Rate)
+	fromDecimals := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(amount.Currency().Decimals)), nil)
+	toDecimals := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(toCur.Decimals)), nil)
+	converted.Mul(converted, new(big.Rat).SetInt(toDecimals))
+	converted.Quo(converted, new(big.Rat).SetInt(fromDecimals))
+
+	return money.FromMinorRat(toCur, converted), nil
+}
+
+// ApplySpread applies a percentage spread to an exchange rate, returning a
+// new rate. For buy rates (fiat to crypto), the rate is increased.
+func (s *ExchangeRateService) ApplySpread(rate *big.Rat, spreadPercentage *big.Rat) *big.Rat {
+	if spreadPercentage.Sign() < 0 {
+		return new(big.Rat).Set(rate)
+	}
+
+	// adjusted = rate * (1 + spreadPercentage/100)
+	factor := new(big.Rat).Quo(spreadPercentage, big.NewRat(100, 1))
+	factor.Add(factor, big.NewRat(1, 1))
+
+	return new(big.Rat).Mul(rate, factor)
+}
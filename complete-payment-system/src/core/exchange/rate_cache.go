@@ -0,0 +1,56 @@
+package exchange
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateCache persists AggregatedRate results keyed by (fiat, crypto) with
+// a per-entry TTL, so GetRate doesn't recompute a TWAP from scratch on
+// every call. Mirrors notification.NotificationStore's
+// interface-plus-backend convention: MemoryRateCache is the default,
+// single-process backend and RedisRateCache lets multiple gateway
+// instances share one cache.
+type RateCache interface {
+	Get(ctx context.Context, fiatCurrency, cryptoCurrency string) (*AggregatedRate, bool, error)
+	Set(ctx context.Context, fiatCurrency, cryptoCurrency string, rate *AggregatedRate, ttl time.Duration) error
+}
+
+// memoryCacheEntry pairs a cached rate with the time it expires.
+type memoryCacheEntry struct {
+	rate      *AggregatedRate
+	expiresAt time.Time
+}
+
+// MemoryRateCache is an in-memory RateCache, suitable for a single
+// gateway process.
+type MemoryRateCache struct {
+	mu      sync.RWMutex
+	entries map[string]memoryCacheEntry
+}
+
+// NewMemoryRateCache creates an empty MemoryRateCache.
+func NewMemoryRateCache() *MemoryRateCache {
+	return &MemoryRateCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+// Get implements RateCache.
+func (c *MemoryRateCache) Get(ctx context.Context, fiatCurrency, cryptoCurrency string) (*AggregatedRate, bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[pairKey(fiatCurrency, cryptoCurrency)]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false, nil
+	}
+	return entry.rate, true, nil
+}
+
+// Set implements RateCache.
+func (c *MemoryRateCache) Set(ctx context.Context, fiatCurrency, cryptoCurrency string, rate *AggregatedRate, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[pairKey(fiatCurrency, cryptoCurrency)] = memoryCacheEntry{rate: rate, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
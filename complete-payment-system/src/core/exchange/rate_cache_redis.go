@@ -0,0 +1,102 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisRateRecord is the JSON-serializable form of an AggregatedRate.
+// big.Rat doesn't implement json.Marshaler, so Rate and StdDev are
+// round-tripped through their decimal string representation instead.
+type redisRateRecord struct {
+	FiatCurrency   string    `json:"fiat_currency"`
+	CryptoCurrency string    `json:"crypto_currency"`
+	RateDecimal    string    `json:"rate"`
+	StdDevDecimal  string    `json:"std_dev"`
+	LastUpdated    time.Time `json:"last_updated"`
+	VenueCount     int       `json:"venue_count"`
+}
+
+// RedisRateCache is a Redis-backed RateCache, letting multiple gateway
+// instances share aggregated exchange rates instead of each recomputing
+// its own TWAP.
+type RedisRateCache struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisRateCache creates a RedisRateCache using client, namespacing
+// all keys under prefix (e.g. "exchange_rates") so it can share a Redis
+// instance with other subsystems.
+func NewRedisRateCache(client *redis.Client, prefix string) *RedisRateCache {
+	return &RedisRateCache{client: client, prefix: prefix}
+}
+
+func (c *RedisRateCache) key(fiatCurrency, cryptoCurrency string) string {
+	return c.prefix + ":" + pairKey(fiatCurrency, cryptoCurrency)
+}
+
+// Get implements RateCache.
+func (c *RedisRateCache) Get(ctx context.Context, fiatCurrency, cryptoCurrency string) (*AggregatedRate, bool, error) {
+	data, err := c.client.Get(ctx, c.key(fiatCurrency, cryptoCurrency)).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("exchange: reading cached rate: %w", err)
+	}
+
+	var rec redisRateRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, false, fmt.Errorf("exchange: decoding cached rate: %w", err)
+	}
+
+	value, ok := new(big.Rat).SetString(rec.RateDecimal)
+	if !ok {
+		return nil, false, fmt.Errorf("exchange: invalid cached rate %q", rec.RateDecimal)
+	}
+	stdDev, ok := new(big.Rat).SetString(rec.StdDevDecimal)
+	if !ok {
+		stdDev = new(big.Rat)
+	}
+
+	return &AggregatedRate{
+		FiatCurrency:   rec.FiatCurrency,
+		CryptoCurrency: rec.CryptoCurrency,
+		Rate:           value,
+		RateDecimal:    rec.RateDecimal,
+		LastUpdated:    rec.LastUpdated,
+		VenueCount:     rec.VenueCount,
+		StdDev:         stdDev,
+	}, true, nil
+}
+
+// Set implements RateCache.
+func (c *RedisRateCache) Set(ctx context.Context, fiatCurrency, cryptoCurrency string, rate *AggregatedRate, ttl time.Duration) error {
+	stdDevDecimal := "0"
+	if rate.StdDev != nil {
+		stdDevDecimal = rate.StdDev.FloatString(12)
+	}
+
+	data, err := json.Marshal(redisRateRecord{
+		FiatCurrency:   rate.FiatCurrency,
+		CryptoCurrency: rate.CryptoCurrency,
+		RateDecimal:    rate.RateDecimal,
+		StdDevDecimal:  stdDevDecimal,
+		LastUpdated:    rate.LastUpdated,
+		VenueCount:     rate.VenueCount,
+	})
+	if err != nil {
+		return fmt.Errorf("exchange: encoding rate: %w", err)
+	}
+
+	if err := c.client.Set(ctx, c.key(fiatCurrency, cryptoCurrency), data, ttl).Err(); err != nil {
+		return fmt.Errorf("exchange: caching rate: %w", err)
+	}
+	return nil
+}
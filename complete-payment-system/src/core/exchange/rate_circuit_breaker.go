@@ -0,0 +1,111 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// circuitState is the state of a CircuitBreakerSource.
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// CircuitBreakerSource wraps a RateProvider so repeated failures from one
+// venue make Fetch return immediately instead of waiting out that
+// venue's request timeout on every aggregation pass. After
+// FailureThreshold consecutive failures the breaker opens and rejects
+// calls for CooldownPeriod; the next call after that admits a single
+// half-open probe, closing again on success or reopening on failure.
+type CircuitBreakerSource struct {
+	Source           RateProvider
+	FailureThreshold int
+	CooldownPeriod   time.Duration
+
+	mu                  sync.Mutex
+	state               circuitState
+	consecutiveFailures int
+	openedAt            time.Time
+	probeInFlight       bool
+}
+
+// NewCircuitBreakerSource wraps source with a circuit breaker that opens
+// after failureThreshold consecutive failures and stays open for cooldown.
+func NewCircuitBreakerSource(source RateProvider, failureThreshold int, cooldown time.Duration) *CircuitBreakerSource {
+	return &CircuitBreakerSource{
+		Source:           source,
+		FailureThreshold: failureThreshold,
+		CooldownPeriod:   cooldown,
+	}
+}
+
+// Name implements RateProvider.
+func (c *CircuitBreakerSource) Name() string { return c.Source.Name() }
+
+// Supports implements RateProvider.
+func (c *CircuitBreakerSource) Supports(fiat, crypto string) bool {
+	return c.Source.Supports(fiat, crypto)
+}
+
+// Fetch implements RateProvider, short-circuiting to an error while the
+// breaker is open instead of calling the wrapped source.
+func (c *CircuitBreakerSource) Fetch(ctx context.Context, fiat, crypto string) (Rate, error) {
+	if !c.allow() {
+		return Rate{}, fmt.Errorf("exchange: circuit breaker open for %s", c.Source.Name())
+	}
+
+	rate, err := c.Source.Fetch(ctx, fiat, crypto)
+	c.record(err == nil)
+	return rate, err
+}
+
+func (c *CircuitBreakerSource) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case circuitOpen:
+		if time.Since(c.openedAt) < c.CooldownPeriod {
+			return false
+		}
+		// Cooldown elapsed: let exactly one probe through.
+		c.state = circuitHalfOpen
+		c.probeInFlight = true
+		return true
+	case circuitHalfOpen:
+		// A probe is already out; deny concurrent callers until record()
+		// resolves it, rather than letting every caller racing in during
+		// the cooldown window hit the still-recovering venue at once.
+		if c.probeInFlight {
+			return false
+		}
+		c.probeInFlight = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (c *CircuitBreakerSource) record(success bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probeInFlight = false
+
+	if success {
+		c.state = circuitClosed
+		c.consecutiveFailures = 0
+		return
+	}
+
+	c.consecutiveFailures++
+	if c.state == circuitHalfOpen || c.consecutiveFailures >= c.FailureThreshold {
+		c.state = circuitOpen
+		c.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,373 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+// Rate is a single quote returned by a RateProvider for a fiat/crypto pair.
+type Rate struct {
+	// Value is how much crypto one unit of fiat buys.
+	Value *big.Rat
+	// Volume is the 24h traded volume backing this quote, in fiat terms.
+	// Nil when the provider doesn't expose volume.
+	Volume    *big.Rat
+	Source    string
+	FetchedAt time.Time
+}
+
+// RateProvider is a source of fiat/crypto exchange rate quotes. Providers
+// are expected to honor ctx cancellation and apply their own request
+// timeout rather than blocking indefinitely.
+type RateProvider interface {
+	// Name identifies the provider for logging and metrics.
+	Name() string
+
+	// Supports reports whether this provider can quote the given pair.
+	Supports(fiat, crypto string) bool
+
+	// Fetch retrieves a single quote for the pair.
+	Fetch(ctx context.Context, fiat, crypto string) (Rate, error)
+}
+
+// Source is an alias for RateProvider: the pluggable venue abstraction
+// CoinGeckoProvider, BinanceProvider, CoinbaseProvider, KrakenProvider,
+// and CircuitBreakerSource all implement.
+type Source = RateProvider
+
+// httpGetJSON performs a GET request and decodes a JSON response body,
+// honoring ctx for cancellation and deadlines.
+func httpGetJSON(ctx context.Context, client *http.Client, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s: unexpected status %d: %s", url, resp.StatusCode, string(body))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// coinGeckoIDs maps ticker symbols to CoinGecko coin IDs for the
+// cryptocurrencies this system supports.
+var coinGeckoIDs = map[string]string{
+	"BTC":  "bitcoin",
+	"ETH":  "ethereum",
+	"USDT": "tether",
+	"BNB":  "binancecoin",
+}
+
+// CoinGeckoProvider fetches spot prices from the public CoinGecko API.
+type CoinGeckoProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewCoinGeckoProvider creates a CoinGeckoProvider with the given
+// per-request timeout.
+func NewCoinGeckoProvider(timeout time.Duration) *CoinGeckoProvider {
+	return &CoinGeckoProvider{
+		client:  &http.Client{Timeout: timeout},
+		baseURL: "https://api.coingecko.com/api/v3",
+	}
+}
+
+func (p *CoinGeckoProvider) Name() string { return "coingecko" }
+
+func (p *CoinGeckoProvider) Supports(fiat, crypto string) bool {
+	_, ok := coinGeckoIDs[crypto]
+	return ok
+}
+
+func (p *CoinGeckoProvider) Fetch(ctx context.Context, fiat, crypto string) (Rate, error) {
+	coinID, ok := coinGeckoIDs[crypto]
+	if !ok {
+		return Rate{}, fmt.Errorf("coingecko: unsupported crypto %q", crypto)
+	}
+
+	url := fmt.Sprintf("%s/simple/price?ids=%s&vs_currencies=%s&include_24hr_vol=true",
+		p.baseURL, coinID, fiat)
+
+	var body map[string]map[string]float64
+	if err := httpGetJSON(ctx, p.client, url, &body); err != nil {
+		return Rate{}, fmt.Errorf("coingecko: %w", err)
+	}
+
+	fiatLower := toLower(fiat)
+	quote, ok := body[coinID]
+	if !ok {
+		return Rate{}, fmt.Errorf("coingecko: no quote for %s", coinID)
+	}
+	price, ok := quote[fiatLower]
+	if !ok {
+		return Rate{}, fmt.Errorf("coingecko: no %s price for %s", fiat, coinID)
+	}
+
+	// CoinGecko quotes crypto price in fiat (fiat per 1 crypto); our Rate is
+	// crypto per 1 fiat, so invert.
+	value := new(big.Rat).Inv(big.NewRat(0, 1).SetFloat64(price))
+
+	rate := Rate{
+		Value:     value,
+		Source:    p.Name(),
+		FetchedAt: time.Now(),
+	}
+	if vol, ok := quote[fiatLower+"_24h_vol"]; ok {
+		rate.Volume = new(big.Rat).SetFloat64(vol)
+	}
+	return rate, nil
+}
+
+// BinanceProvider fetches spot prices from the public Binance ticker API.
+type BinanceProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewBinanceProvider creates a BinanceProvider with the given per-request
+// timeout.
+func NewBinanceProvider(timeout time.Duration) *BinanceProvider {
+	return &BinanceProvider{
+		client:  &http.Client{Timeout: timeout},
+		baseURL: "https://api.binance.com/api/v3",
+	}
+}
+
+func (p *BinanceProvider) Name() string { return "binance" }
+
+func (p *BinanceProvider) Supports(fiat, crypto string) bool {
+	// Binance only quotes a handful of fiats directly against crypto.
+	switch fiat {
+	case "USD", "IDR":
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *BinanceProvider) Fetch(ctx context.Context, fiat, crypto string) (Rate, error) {
+	symbol := crypto + fiatToBinanceQuote(fiat)
+
+	var ticker struct {
+		Symbol string `json:"symbol"`
+		Price  string `json:"price"`
+	}
+	url := fmt.Sprintf("%s/ticker/price?symbol=%s", p.baseURL, symbol)
+	if err := httpGetJSON(ctx, p.client, url, &ticker); err != nil {
+		return Rate{}, fmt.Errorf("binance: %w", err)
+	}
+
+	price, ok := new(big.Rat).SetString(ticker.Price)
+	if !ok {
+		return Rate{}, fmt.Errorf("binance: invalid price %q for %s", ticker.Price, symbol)
+	}
+
+	return Rate{
+		Value:     new(big.Rat).Inv(price),
+		Source:    p.Name(),
+		FetchedAt: time.Now(),
+	}, nil
+}
+
+// fiatToBinanceQuote maps a fiat code to the quote asset Binance lists it
+// under (Binance trades USDT-settled pairs for USD, for example).
+func fiatToBinanceQuote(fiat string) string {
+	switch fiat {
+	case "USD":
+		return "USDT"
+	default:
+		return fiat
+	}
+}
+
+// CoinbaseProvider fetches spot prices from the public Coinbase
+// Exchange ticker API.
+type CoinbaseProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewCoinbaseProvider creates a CoinbaseProvider with the given
+// per-request timeout.
+func NewCoinbaseProvider(timeout time.Duration) *CoinbaseProvider {
+	return &CoinbaseProvider{
+		client:  &http.Client{Timeout: timeout},
+		baseURL: "https://api.exchange.coinbase.com",
+	}
+}
+
+func (p *CoinbaseProvider) Name() string { return "coinbase" }
+
+func (p *CoinbaseProvider) Supports(fiat, crypto string) bool {
+	// Coinbase Exchange only quotes directly against USD.
+	return fiat == "USD"
+}
+
+func (p *CoinbaseProvider) Fetch(ctx context.Context, fiat, crypto string) (Rate, error) {
+	var ticker struct {
+		Price  string `json:"price"`
+		Volume string `json:"volume"`
+	}
+	url := fmt.Sprintf("%s/products/%s-%s/ticker", p.baseURL, crypto, fiat)
+	if err := httpGetJSON(ctx, p.client, url, &ticker); err != nil {
+		return Rate{}, fmt.Errorf("coinbase: %w", err)
+	}
+
+	price, ok := new(big.Rat).SetString(ticker.Price)
+	if !ok {
+		return Rate{}, fmt.Errorf("coinbase: invalid price %q for %s-%s", ticker.Price, crypto, fiat)
+	}
+
+	rate := Rate{
+		Value:     new(big.Rat).Inv(price),
+		Source:    p.Name(),
+		FetchedAt: time.Now(),
+	}
+	// Coinbase's ticker volume is denominated in the base asset (crypto),
+	// but Rate.Volume must be fiat-denominated (see the Rate doc comment
+	// above) to be comparable across providers in volumeWeightedAverage;
+	// convert using the price we already have.
+	if volume, ok := new(big.Rat).SetString(ticker.Volume); ok {
+		rate.Volume = new(big.Rat).Mul(volume, price)
+	}
+	return rate, nil
+}
+
+// KrakenProvider fetches spot prices from the public Kraken ticker API.
+type KrakenProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewKrakenProvider creates a KrakenProvider with the given per-request
+// timeout.
+func NewKrakenProvider(timeout time.Duration) *KrakenProvider {
+	return &KrakenProvider{
+		client:  &http.Client{Timeout: timeout},
+		baseURL: "https://api.kraken.com/0/public",
+	}
+}
+
+func (p *KrakenProvider) Name() string { return "kraken" }
+
+func (p *KrakenProvider) Supports(fiat, crypto string) bool {
+	_, ok := krakenPairs[crypto+fiat]
+	return ok
+}
+
+// krakenPairs maps "<crypto><fiat>" to the pair name Kraken's ticker
+// endpoint expects and returns results keyed by.
+var krakenPairs = map[string]string{
+	"BTCUSD": "XXBTZUSD",
+	"ETHUSD": "XETHZUSD",
+}
+
+func (p *KrakenProvider) Fetch(ctx context.Context, fiat, crypto string) (Rate, error) {
+	pair, ok := krakenPairs[crypto+fiat]
+	if !ok {
+		return Rate{}, fmt.Errorf("kraken: unsupported pair %s/%s", crypto, fiat)
+	}
+
+	var body struct {
+		Error  []string `json:"error"`
+		Result map[string]struct {
+			Price  []string `json:"c"` // last trade closed [price, lot volume]
+			Volume []string `json:"v"` // volume [today, last 24 hours]
+		} `json:"result"`
+	}
+	url := fmt.Sprintf("%s/Ticker?pair=%s", p.baseURL, pair)
+	if err := httpGetJSON(ctx, p.client, url, &body); err != nil {
+		return Rate{}, fmt.Errorf("kraken: %w", err)
+	}
+	if len(body.Error) > 0 {
+		return Rate{}, fmt.Errorf("kraken: %v", body.Error)
+	}
+
+	ticker, ok := body.Result[pair]
+	if !ok || len(ticker.Price) == 0 {
+		return Rate{}, fmt.Errorf("kraken: no ticker data for %s", pair)
+	}
+
+	price, ok := new(big.Rat).SetString(ticker.Price[0])
+	if !ok {
+		return Rate{}, fmt.Errorf("kraken: invalid price %q for %s", ticker.Price[0], pair)
+	}
+
+	rate := Rate{
+		Value:     new(big.Rat).Inv(price),
+		Source:    p.Name(),
+		FetchedAt: time.Now(),
+	}
+	// Kraken's ticker volume (like Coinbase's) is denominated in the
+	// base asset; convert to fiat terms for volumeWeightedAverage.
+	if len(ticker.Volume) > 1 {
+		if volume, ok := new(big.Rat).SetString(ticker.Volume[1]); ok {
+			rate.Volume = new(big.Rat).Mul(volume, price)
+		}
+	}
+	return rate, nil
+}
+
+// StaticProvider serves fixed example rates. It is always available and is
+// used as the baseline/fallback provider when no live providers are
+// registered or reachable.
+type StaticProvider struct {
+	rates map[string]map[string]string
+}
+
+// NewStaticProvider creates a StaticProvider seeded with the given table of
+// decimal rate strings, keyed by fiat then crypto.
+func NewStaticProvider(rates map[string]map[string]string) *StaticProvider {
+	return &StaticProvider{rates: rates}
+}
+
+func (p *StaticProvider) Name() string { return "static" }
+
+func (p *StaticProvider) Supports(fiat, crypto string) bool {
+	fiatRates, ok := p.rates[fiat]
+	if !ok {
+		return false
+	}
+	_, ok = fiatRates[crypto]
+	return ok
+}
+
+func (p *StaticProvider) Fetch(ctx context.Context, fiat, crypto string) (Rate, error) {
+	fiatRates, ok := p.rates[fiat]
+	if !ok {
+		return Rate{}, fmt.Errorf("static: no rates for fiat %q", fiat)
+	}
+	decimal, ok := fiatRates[crypto]
+	if !ok {
+		return Rate{}, fmt.Errorf("static: no rate for %s/%s", fiat, crypto)
+	}
+	value, ok := new(big.Rat).SetString(decimal)
+	if !ok {
+		return Rate{}, fmt.Errorf("static: invalid rate %q for %s/%s", decimal, fiat, crypto)
+	}
+	return Rate{Value: value, Source: p.Name(), FetchedAt: time.Now()}, nil
+}
+
+func toLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if c >= 'A' && c <= 'Z' {
+			b[i] = c + ('a' - 'A')
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,340 @@
+package exchange
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// rateSample is one cross-venue quote captured by the background
+// sampler at a point in time, feeding GetRate's time-weighted average.
+type rateSample struct {
+	value      *big.Rat
+	at         time.Time
+	venueCount int
+}
+
+// AggregatedRate is the result of GetRate: a time-weighted, outlier-
+// filtered rate for a fiat/crypto pair over the service's sample
+// window, along with enough of the inputs that produced it for a
+// caller to judge its quality.
+type AggregatedRate struct {
+	FiatCurrency   string
+	CryptoCurrency string
+
+	// Rate is how much CryptoCurrency one unit of FiatCurrency buys.
+	Rate        *big.Rat
+	RateDecimal string
+
+	// LastUpdated is the timestamp of the newest sample folded into Rate.
+	LastUpdated time.Time
+
+	// VenueCount is the number of distinct venues backing the newest
+	// surviving sample.
+	VenueCount int
+
+	// StdDev is the standard deviation of the samples that survived MAD
+	// outlier rejection, in the same units as Rate. A larger StdDev
+	// means the venues disagreed more over the sample window.
+	StdDev *big.Rat
+}
+
+// Staleness is how long ago LastUpdated was.
+func (r *AggregatedRate) Staleness() time.Duration {
+	return time.Since(r.LastUpdated)
+}
+
+// StartSampling begins a background goroutine that calls fetchQuotes for
+// (fiatCurrency, cryptoCurrency) every sampleInterval and appends the
+// cross-venue median to that pair's sample buffer, trimming samples
+// older than sampleWindow. GetRate folds this buffer into a TWAP. It is
+// a no-op if sampling for this pair is already running. Call the
+// returned stop function (or Close) to end it, e.g. on server shutdown.
+func (s *ExchangeRateService) StartSampling(ctx context.Context, fiatCurrency, cryptoCurrency string) (stop func()) {
+	key := pairKey(fiatCurrency, cryptoCurrency)
+
+	s.samplingMutex.Lock()
+	if _, running := s.samplingPairs[key]; running {
+		s.samplingMutex.Unlock()
+		return func() {}
+	}
+	sampleCtx, cancel := context.WithCancel(ctx)
+	s.samplingPairs[key] = cancel
+	s.samplingMutex.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(s.sampleInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-sampleCtx.Done():
+				return
+			case <-ticker.C:
+				s.takeSample(sampleCtx, fiatCurrency, cryptoCurrency)
+			}
+		}
+	}()
+
+	return func() {
+		s.samplingMutex.Lock()
+		defer s.samplingMutex.Unlock()
+		if c, ok := s.samplingPairs[key]; ok {
+			c()
+			delete(s.samplingPairs, key)
+		}
+	}
+}
+
+// Close stops every background sampler started by StartSampling.
+func (s *ExchangeRateService) Close() {
+	s.samplingMutex.Lock()
+	defer s.samplingMutex.Unlock()
+	for key, cancel := range s.samplingPairs {
+		cancel()
+		delete(s.samplingPairs, key)
+	}
+}
+
+func (s *ExchangeRateService) takeSample(ctx context.Context, fiatCurrency, cryptoCurrency string) {
+	quotes, err := s.fetchQuotes(ctx, fiatCurrency, cryptoCurrency)
+	if err != nil {
+		return
+	}
+
+	values := make([]*big.Rat, len(quotes))
+	for i, q := range quotes {
+		values[i] = q.Value
+	}
+	median := medianRat(values)
+
+	key := pairKey(fiatCurrency, cryptoCurrency)
+	s.samplesMutex.Lock()
+	defer s.samplesMutex.Unlock()
+
+	samples := append(s.samples[key], rateSample{value: median, at: time.Now(), venueCount: len(quotes)})
+	cutoff := time.Now().Add(-s.sampleWindow)
+	trimmed := samples[:0]
+	for _, sample := range samples {
+		if sample.at.After(cutoff) {
+			trimmed = append(trimmed, sample)
+		}
+	}
+	s.samples[key] = trimmed
+}
+
+// GetRate returns a time-weighted average rate over the service's
+// sample window (default 60s), with MAD-based outlier rejection across
+// the buffered samples. A fresh ResultCache entry is returned without
+// recomputation. If background sampling for this pair hasn't buffered
+// any samples yet — e.g. this is the first call for a pair and
+// StartSampling hasn't had a chance to run — GetRate lazily starts
+// sampling for next time and, for this call, falls back to aggregate's
+// single cross-venue quote (outlier-rejected and volume-weighted, same
+// as the rest of this service) so callers never block on the sample
+// window warming up.
+func (s *ExchangeRateService) GetRate(ctx context.Context, fiatCurrency, cryptoCurrency string) (*AggregatedRate, error) {
+	if s.ResultCache != nil {
+		if cached, ok, err := s.ResultCache.Get(ctx, fiatCurrency, cryptoCurrency); err == nil && ok {
+			return cached, nil
+		}
+	}
+
+	key := pairKey(fiatCurrency, cryptoCurrency)
+	s.samplesMutex.Lock()
+	buffered := append([]rateSample(nil), s.samples[key]...)
+	s.samplesMutex.Unlock()
+
+	var result *AggregatedRate
+	if len(buffered) == 0 {
+		s.StartSampling(context.Background(), fiatCurrency, cryptoCurrency)
+
+		quotes, err := s.fetchQuotes(ctx, fiatCurrency, cryptoCurrency)
+		if err != nil {
+			return nil, err
+		}
+		rate, venueCount := s.combineQuotes(quotes)
+
+		values := make([]*big.Rat, len(quotes))
+		for i, q := range quotes {
+			values[i] = q.Value
+		}
+		result = &AggregatedRate{
+			FiatCurrency:   fiatCurrency,
+			CryptoCurrency: cryptoCurrency,
+			Rate:           rate,
+			LastUpdated:    time.Now(),
+			VenueCount:     venueCount,
+			StdDev:         stdDevRat(values),
+		}
+	} else {
+		result = twap(fiatCurrency, cryptoCurrency, buffered, s.madK)
+	}
+	result.RateDecimal = result.Rate.FloatString(12)
+
+	if s.ResultCache != nil {
+		_ = s.ResultCache.Set(ctx, fiatCurrency, cryptoCurrency, result, s.ResultTTL)
+	}
+	return result, nil
+}
+
+// GetRateQuote is GetRate with its result flattened to primitive types.
+// core/main.go (package main) shares this directory but, like package
+// security, has no import path it can use to reach package exchange's
+// named types — so PaymentGateway holds ExchangeService as interface{}
+// and type-asserts it to a local structural interface built only from
+// primitives, the same workaround used for SecurityService/
+// jwtAuthenticator.
+func (s *ExchangeRateService) GetRateQuote(ctx context.Context, fiatCurrency, cryptoCurrency string) (rateDecimal string, lastUpdated time.Time, venueCount int, stdDevDecimal string, err error) {
+	result, err := s.GetRate(ctx, fiatCurrency, cryptoCurrency)
+	if err != nil {
+		return "", time.Time{}, 0, "", err
+	}
+	stdDevDecimal = "0"
+	if result.StdDev != nil {
+		stdDevDecimal = result.StdDev.FloatString(12)
+	}
+	return result.RateDecimal, result.LastUpdated, result.VenueCount, stdDevDecimal, nil
+}
+
+// twap computes a MAD-filtered, time-weighted average of samples, which
+// must be sorted or not — it sorts by time itself. Each sample is
+// weighted by the duration it was in effect (the gap to the next
+// sample; the last sample is weighted by the gap to now).
+func twap(fiatCurrency, cryptoCurrency string, samples []rateSample, madK *big.Rat) *AggregatedRate {
+	sort.Slice(samples, func(i, j int) bool { return samples[i].at.Before(samples[j].at) })
+
+	values := make([]*big.Rat, len(samples))
+	for i, sample := range samples {
+		values[i] = sample.value
+	}
+	median := medianRat(values)
+	mad := medianAbsoluteDeviation(values, median)
+
+	filtered := make([]rateSample, 0, len(samples))
+	for _, sample := range samples {
+		if isOutlierMAD(sample.value, median, mad, madK) {
+			continue
+		}
+		filtered = append(filtered, sample)
+	}
+	if len(filtered) == 0 {
+		// Every sample looked like an outlier relative to the others;
+		// fall back to the unfiltered set rather than failing outright.
+		filtered = samples
+	}
+
+	now := time.Now()
+	weightedSum := new(big.Rat)
+	totalWeight := new(big.Rat)
+	for i, sample := range filtered {
+		until := now
+		if i+1 < len(filtered) {
+			until = filtered[i+1].at
+		}
+		weight := big.NewRat(int64(until.Sub(sample.at)), int64(time.Second))
+		if weight.Sign() <= 0 {
+			weight = big.NewRat(1, 1000) // samples taken back-to-back still count
+		}
+		weightedSum.Add(weightedSum, new(big.Rat).Mul(sample.value, weight))
+		totalWeight.Add(totalWeight, weight)
+	}
+
+	rate := median
+	if totalWeight.Sign() > 0 {
+		rate = new(big.Rat).Quo(weightedSum, totalWeight)
+	}
+
+	filteredValues := make([]*big.Rat, len(filtered))
+	for i, sample := range filtered {
+		filteredValues[i] = sample.value
+	}
+
+	// Report LastUpdated/VenueCount from the newest surviving sample, not
+	// the newest raw sample — if the most recent sample was itself
+	// rejected as an outlier above, it played no part in rate and
+	// shouldn't be reported as if it had.
+	newest := filtered[len(filtered)-1]
+	return &AggregatedRate{
+		FiatCurrency:   fiatCurrency,
+		CryptoCurrency: cryptoCurrency,
+		Rate:           rate,
+		LastUpdated:    newest.at,
+		VenueCount:     newest.venueCount,
+		StdDev:         stdDevRat(filteredValues),
+	}
+}
+
+// medianAbsoluteDeviation returns median(|x_i - median|) for values.
+func medianAbsoluteDeviation(values []*big.Rat, median *big.Rat) *big.Rat {
+	deviations := make([]*big.Rat, len(values))
+	for i, v := range values {
+		d := new(big.Rat).Sub(v, median)
+		deviations[i] = d.Abs(d)
+	}
+	return medianRat(deviations)
+}
+
+// isOutlierMAD reports whether value deviates from median by more than
+// k * mad, the median-absolute-deviation outlier rule. When mad is zero
+// (every sample identical), any deviation at all is an outlier.
+func isOutlierMAD(value, median, mad, k *big.Rat) bool {
+	diff := new(big.Rat).Sub(value, median)
+	diff.Abs(diff)
+	if mad.Sign() == 0 {
+		return diff.Sign() != 0
+	}
+	return diff.Cmp(new(big.Rat).Mul(mad, k)) > 0
+}
+
+// stdDevRat returns the (population) standard deviation of values as a
+// big.Rat, computed via Newton's method to a fixed precision since
+// big.Rat has no native square root.
+func stdDevRat(values []*big.Rat) *big.Rat {
+	if len(values) == 0 {
+		return new(big.Rat)
+	}
+
+	mean := new(big.Rat)
+	for _, v := range values {
+		mean.Add(mean, v)
+	}
+	mean.Quo(mean, big.NewRat(int64(len(values)), 1))
+
+	variance := new(big.Rat)
+	for _, v := range values {
+		d := new(big.Rat).Sub(v, mean)
+		variance.Add(variance, new(big.Rat).Mul(d, d))
+	}
+	variance.Quo(variance, big.NewRat(int64(len(values)), 1))
+
+	return sqrtRat(variance)
+}
+
+// sqrtRat approximates the square root of a non-negative big.Rat using
+// Newton's method, to 1e-18 relative precision or 40 iterations,
+// whichever comes first.
+func sqrtRat(x *big.Rat) *big.Rat {
+	if x.Sign() <= 0 {
+		return new(big.Rat)
+	}
+
+	guess := new(big.Rat).Set(x)
+	two := big.NewRat(2, 1)
+	epsilon := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+
+	for i := 0; i < 40; i++ {
+		next := new(big.Rat).Quo(x, guess)
+		next.Add(next, guess)
+		next.Quo(next, two)
+
+		diff := new(big.Rat).Sub(next, guess)
+		diff.Abs(diff)
+		guess = next
+		if diff.Cmp(epsilon) < 0 {
+			break
+		}
+	}
+	return guess
+}
@@ -1,213 +0,0 @@
-package main
-
-import (
-	"encoding/json"
-	"fmt"
-	"log"
-	"net/http"
-	"time"
-)
-
-// PaymentGateway is the main entry point for the payment system
-type PaymentGateway struct {
-	PaymentProcessor   interface{} // Would be the actual payment processor in production
-	WalletService      interface{} // Would be the actual wallet service in production
-	ExchangeService    interface{} // Would be the actual exchange service in production
-	SecurityService    interface{} // Would be the actual security service in production
-	NotificationService interface{} // Would be the actual notification service in production
-}
-
-// NewPaymentGateway creates a new instance of PaymentGateway
-func NewPaymentGateway() *PaymentGateway {
-	return &PaymentGateway{
-		// In a real implementation, we would initialize all services
-	}
-}
-
-// PaymentRequest represents a request to create a new payment
-type PaymentRequest struct {
-	Amount          float64            `json:"amount"`
-	Currency        string             `json:"currency"`
-	CryptoCurrency  string             `json:"crypto_currency"`
-	MerchantID      string             `json:"merchant_id"`
-	CountryCode     string             `json:"country_code"`
-	CallbackURL     string             `json:"callback_url,omitempty"`
-	CustomerEmail   string             `json:"customer_email,omitempty"`
-	CustomerPhone   string             `json:"customer_phone,omitempty"`
-	Metadata        map[string]interface{} `json:"metadata,omitempty"`
-}
-
-// PaymentResponse represents the response to a payment request
-type PaymentResponse struct {
-	Success         bool               `json:"success"`
-	TransactionID   string             `json:"transaction_id,omitempty"`
-	PaymentURL      string             `json:"payment_url,omitempty"`
-	PaymentAddress  string             `json:"payment_address,omitempty"`
-	Amount          float64            `json:"amount,omitempty"`
-	CryptoAmount    float64            `json:"crypto_amount,omitempty"`
-	Currency        string             `json:"currency,omitempty"`
-	CryptoCurrency  string             `json:"crypto_currency,omitempty"`
-	ExchangeRate    float64            `json:"exchange_rate,omitempty"`
-	ExpiresAt       time.Time          `json:"expires_at,omitempty"`
-	Error           string             `json:"error,omitempty"`
-}
-
-// TransactionStatusResponse represents the response to a transaction status request
-type TransactionStatusResponse struct {
-	Success         bool               `json:"success"`
-	TransactionID   string             `json:"transaction_id,omitempty"`
-	Status          string             `json:"status,omitempty"`
-	Amount          float64            `json:"amount,omitempty"`
-	CryptoAmount    float64            `json:"crypto_amount,omitempty"`
-	Currency        string             `json:"currency,omitempty"`
-	CryptoCurrency  string             `json:"crypto_currency,omitempty"`
-	CompletedAt     time.Time          `json:"completed_at,omitempty"`
-	Error           string             `json:"error,omitempty"`
-}
-
-// StartServer starts the HTTP server for the payment gateway
-func (g *PaymentGateway) StartServer(port int) error {
-	// Set up HTTP routes
-	http.HandleFunc("/api/v1/payments", g.handleCreatePayment)
-	http.HandleFunc("/api/v1/payments/", g.handleGetPayment)
-	http.HandleFunc("/api/v1/exchange-rates", g.handleGetExchangeRates)
-	
-	// Start the server
-	addr := fmt.Sprintf("0.0.0.0:%d", port)
-	log.Printf("Starting server on %s", addr)
-	return http.ListenAndServe(addr, nil)
-}
-
-// handleCreatePayment handles requests to create a new payment
-func (g *PaymentGateway) handleCreatePayment(w http.ResponseWriter, r *http.Request) {
-	// Only allow POST requests
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-	
-	// Parse the request body
-	var req PaymentRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-	
-	// Validate the request
-	if req.Amount <= 0 || req.Currency == "" || req.CryptoCurrency == "" || req.MerchantID == "" || req.CountryCode == "" {
-		http.Error(w, "Missing required fields", http.StatusBadRequest)
-		return
-	}
-	
-	// In a real implementation, we would:
-	// 1. Authenticate the merchant
-	// 2. Create a transaction using the payment processor
-	// 3. Generate a payment address using the wallet service
-	// 4. Calculate the exchange rate using the exchange service
-	// 5. Return the payment details
-	
-	// For now, we'll return a placeholder response
-	resp := PaymentResponse{
-		Success:        true,
-		TransactionID:  "tx_" + time.Now().Format("20060102150405"),
-		PaymentURL:     "https://pay.example.com/tx_" + time.Now().Format("20060102150405"),
-		PaymentAddress: "0x1234567890abcdef1234567890abcdef12345678",
-		Amount:         req.Amount,
-		CryptoAmount:   req.Amount * 0.0001, // Example rate
-		Currency:       req.Currency,
-		CryptoCurrency: req.CryptoCurrency,
-		ExchangeRate:   0.0001,
-		ExpiresAt:      time.Now().Add(1 * time.Hour),
-	}
-	
-	// Return the response as JSON
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(resp)
-}
-
-// handleGetPayment handles requests to get payment status
-func (g *PaymentGateway) handleGetPayment(w http.ResponseWriter, r *http.Request) {
-	// Only allow GET requests
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-	
-	// Extract the transaction ID from the URL
-	// URL format: /api/v1/payments/{transaction_id}
-	transactionID := r.URL.Path[len("/api/v1/payments/"):]
-	if transactionID == "" {
-		http.Error(w, "Missing transaction ID", http.StatusBadRequest)
-		return
-	}
-	
-	// In a real implementation, we would:
-	// 1. Authenticate the request
-	// 2. Retrieve the transaction using the payment processor
-	// 3. Return the transaction status
-	
-	// For now, we'll return a placeholder response
-	resp := TransactionStatusResponse{
-		Success:       true,
-		TransactionID: transactionID,
-		Status:        "pending",
-		Amount:        100.0,
-		CryptoAmount:  0.01,
-		Currency:      "USD",
-		CryptoCurrency: "BTC",
-	}
-	
-	// Return the response as JSON
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(resp)
-}
-
-// handleGetExchangeRates handles requests to get exchange rates
-func (g *PaymentGateway) handleGetExchangeRates(w http.ResponseWriter, r *http.Request) {
-	// Only allow GET requests
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-	
-	// Extract query parameters
-	fiatCurrency := r.URL.Query().Get("fiat")
-	cryptoCurrency := r.URL.Query().Get("crypto")
-	
-	// In a real implementation, we would:
-	// 1. Validate the parameters
-	// 2. Retrieve the exchange rate using the exchange service
-	// 3. Return the exchange rate
-	
-	// For now, we'll return a placeholder response
-	type ExchangeRateResponse struct {
-		Success        bool    `json:"success"`
-		FiatCurrency   string  `json:"fiat_currency,omitempty"`
-		CryptoCurrency string  `json:"crypto_currency,omitempty"`
-		Rate           float64 `json:"rate,omitempty"`
-		LastUpdated    string  `json:"last_updated,omitempty"`
-		Error          string  `json:"error,omitempty"`
-	}
-	
-	resp := ExchangeRateResponse{
-		Success:        true,
-		FiatCurrency:   fiatCurrency,
-		CryptoCurrency: cryptoCurrency,
-		Rate:           0.0001,
-		LastUpdated:    time.Now().Format(time.RFC3339),
-	}
-	
-	// Return the response as JSON
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(resp)
-}
-
-func main() {
-	// Create a new payment gateway
-	gateway := NewPaymentGateway()
-	
-	// Start the server
-	if err := gateway.StartServer(8080); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
-	}
-}
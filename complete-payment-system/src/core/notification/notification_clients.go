@@ -0,0 +1,42 @@
+package notification
+
+import "context"
+
+// EmailClient sends an email notification through a real provider
+// (SES, SendGrid, ...). Implementations are wired in by the caller;
+// package notification has no provider-specific code.
+type EmailClient interface {
+	SendEmail(ctx context.Context, recipient, subject, content string) error
+}
+
+// SMSClient sends an SMS notification through a real provider (Twilio,
+// SNS, ...).
+type SMSClient interface {
+	SendSMS(ctx context.Context, recipient, content string) error
+}
+
+// WebhookClient posts a notification payload to a recipient URL. It
+// takes the full Notification, rather than just recipient/content like
+// the other channels, so an implementation can sign the request over
+// Notification.ID/Content and record delivery attempts, response
+// codes, and latencies back onto Notification.Metadata (see package
+// webhook's Client for the production implementation).
+type WebhookClient interface {
+	SendWebhook(ctx context.Context, n *Notification) error
+}
+
+// PushClient sends a push notification through a real provider (FCM,
+// APNs, ...).
+type PushClient interface {
+	SendPush(ctx context.Context, recipient, subject, content string) error
+}
+
+// Clients bundles the provider clients the Dispatcher sends through,
+// one per channel. A nil field means that channel isn't configured;
+// the Dispatcher fails notifications for it rather than panicking.
+type Clients struct {
+	Email   EmailClient
+	SMS     SMSClient
+	Webhook WebhookClient
+	Push    PushClient
+}
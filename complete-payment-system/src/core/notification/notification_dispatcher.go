@@ -0,0 +1,243 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// defaultWorkers is how many notifications the Dispatcher sends
+// concurrently.
+const defaultWorkers = 4
+
+// defaultPollInterval is how often the Dispatcher checks the store for
+// due notifications.
+const defaultPollInterval = time.Second
+
+// defaultBaseBackoff and defaultMaxBackoff bound the exponential
+// backoff applied between retry attempts: 1s, 2s, 4s, ... capped at
+// 1h. Shared across every channel rather than just webhooks — nothing
+// in this package distinguishes channels for backoff purposes, and a
+// 1h ceiling is no worse for email/SMS/push than the 5m this used to
+// be. Per-notification attempt budgets (Notification.MaxAttempts)
+// already vary by channel; that's where webhook's 24-attempt patience
+// is set (see notification.CreateTransactionNotification).
+const (
+	defaultBaseBackoff = time.Second
+	defaultMaxBackoff  = time.Hour
+)
+
+// Dispatcher is the background worker pool that pulls due notifications
+// from a NotificationStore and sends them through the configured
+// Clients, retrying failures with exponential backoff and jitter until
+// MaxAttempts is exhausted, at which point the notification moves to
+// NotificationStatusDeadLetter.
+type Dispatcher struct {
+	Store   NotificationStore
+	Clients Clients
+
+	// Limiters holds one RateLimiter per channel; a channel without an
+	// entry is unlimited.
+	Limiters map[NotificationType]RateLimiter
+
+	Workers      int
+	PollInterval time.Duration
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDispatcher creates a Dispatcher with repo-standard defaults: 4
+// workers, a 1s poll interval, and exponential backoff from 1s up to
+// 1h.
+func NewDispatcher(store NotificationStore, clients Clients) *Dispatcher {
+	return &Dispatcher{
+		Store:        store,
+		Clients:      clients,
+		Limiters:     make(map[NotificationType]RateLimiter),
+		Workers:      defaultWorkers,
+		PollInterval: defaultPollInterval,
+		BaseBackoff:  defaultBaseBackoff,
+		MaxBackoff:   defaultMaxBackoff,
+	}
+}
+
+// Start launches the poller and worker goroutines. It returns
+// immediately; call Stop to shut them down.
+func (d *Dispatcher) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	d.cancel = cancel
+
+	jobs := make(chan Notification)
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer close(jobs)
+		d.poll(ctx, jobs)
+	}()
+
+	for i := 0; i < d.workerCount(); i++ {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			for n := range jobs {
+				d.attempt(ctx, n)
+			}
+		}()
+	}
+}
+
+// Stop cancels the poller and worker goroutines and waits for them to
+// exit.
+func (d *Dispatcher) Stop() {
+	if d.cancel != nil {
+		d.cancel()
+	}
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) workerCount() int {
+	if d.Workers <= 0 {
+		return defaultWorkers
+	}
+	return d.Workers
+}
+
+func (d *Dispatcher) pollInterval() time.Duration {
+	if d.PollInterval <= 0 {
+		return defaultPollInterval
+	}
+	return d.PollInterval
+}
+
+// leaseDuration is how long a notification handed to a worker is kept
+// out of DuePending's results, so a slow send can't be picked up a
+// second time by the next poll tick before attempt() persists its
+// outcome.
+const leaseDuration = 30 * time.Second
+
+func (d *Dispatcher) poll(ctx context.Context, jobs chan<- Notification) {
+	ticker := time.NewTicker(d.pollInterval())
+	defer ticker.Stop()
+
+	for {
+		due, err := d.Store.DuePending(ctx, d.workerCount()*4)
+		if err == nil {
+			for _, n := range due {
+				n.NextAttemptAt = time.Now().Add(leaseDuration)
+				if err := d.Store.Save(ctx, n); err != nil {
+					continue
+				}
+				select {
+				case jobs <- n:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// limiterFor returns the configured RateLimiter for channel, or an
+// unlimited default if none was set.
+func (d *Dispatcher) limiterFor(channel NotificationType) RateLimiter {
+	if l, ok := d.Limiters[channel]; ok && l != nil {
+		return l
+	}
+	return unlimited{}
+}
+
+// backoff computes the exponential-with-jitter delay before attempt
+// number attempt (1-indexed).
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	base := d.BaseBackoff
+	if base <= 0 {
+		base = defaultBaseBackoff
+	}
+	max := d.MaxBackoff
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+
+	delay := base << uint(attempt-1) // base * 2^(attempt-1)
+	if delay <= 0 || delay > max {   // guard against overflow from large attempt counts
+		delay = max
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay/2 + jitter
+}
+
+// attempt sends a single notification and records the outcome,
+// including moving it to NotificationStatusDeadLetter once MaxAttempts
+// is exhausted.
+func (d *Dispatcher) attempt(ctx context.Context, n Notification) {
+	if !d.limiterFor(n.Type).Allow() {
+		return // reconsidered on the next poll
+	}
+
+	n.Attempts++
+	sendErr := d.send(ctx, &n)
+
+	if sendErr == nil {
+		n.Status = NotificationStatusSent
+		n.SentAt = time.Now()
+		n.LastError = ""
+	} else {
+		n.LastError = sendErr.Error()
+		maxAttempts := n.MaxAttempts
+		if maxAttempts <= 0 {
+			maxAttempts = defaultMaxAttempts
+		}
+		if n.Attempts >= maxAttempts {
+			n.Status = NotificationStatusDeadLetter
+		} else {
+			n.Status = NotificationStatusFailed
+			n.NextAttemptAt = time.Now().Add(d.backoff(n.Attempts))
+		}
+	}
+
+	_ = d.Store.Save(ctx, n)
+}
+
+// send dispatches n to the client registered for its channel. n is
+// passed by pointer only for the webhook channel so WebhookClient can
+// record delivery attempts/response codes/latencies onto
+// n.Metadata before the caller persists n.
+func (d *Dispatcher) send(ctx context.Context, n *Notification) error {
+	switch n.Type {
+	case NotificationTypeEmail:
+		if d.Clients.Email == nil {
+			return fmt.Errorf("notification: no email client configured")
+		}
+		return d.Clients.Email.SendEmail(ctx, n.Recipient, n.Subject, n.Content)
+	case NotificationTypeSMS:
+		if d.Clients.SMS == nil {
+			return fmt.Errorf("notification: no SMS client configured")
+		}
+		return d.Clients.SMS.SendSMS(ctx, n.Recipient, n.Content)
+	case NotificationTypeWebhook:
+		if d.Clients.Webhook == nil {
+			return fmt.Errorf("notification: no webhook client configured")
+		}
+		return d.Clients.Webhook.SendWebhook(ctx, n)
+	case NotificationTypePush:
+		if d.Clients.Push == nil {
+			return fmt.Errorf("notification: no push client configured")
+		}
+		return d.Clients.Push.SendPush(ctx, n.Recipient, n.Subject, n.Content)
+	default:
+		return fmt.Errorf("notification: unsupported notification type %q", n.Type)
+	}
+}
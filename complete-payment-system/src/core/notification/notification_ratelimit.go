@@ -0,0 +1,62 @@
+package notification
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter caps how often a channel may send, independent of the
+// Dispatcher's worker concurrency.
+type RateLimiter interface {
+	// Allow reports whether a send may proceed right now. A false
+	// result means the caller should leave the notification pending
+	// and try again on a later Dispatcher pass.
+	Allow() bool
+}
+
+// TokenBucketLimiter is a simple token-bucket RateLimiter: it holds up
+// to burst tokens and refills at ratePerSecond.
+type TokenBucketLimiter struct {
+	mu         sync.Mutex
+	tokens     float64
+	burst      float64
+	ratePerSec float64
+	lastRefill time.Time
+}
+
+// NewTokenBucketLimiter creates a limiter allowing ratePerSecond steady
+// throughput with bursts up to burst.
+func NewTokenBucketLimiter(ratePerSecond float64, burst int) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		tokens:     float64(burst),
+		burst:      float64(burst),
+		ratePerSec: ratePerSecond,
+		lastRefill: time.Now(),
+	}
+}
+
+// Allow implements RateLimiter.
+func (l *TokenBucketLimiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(l.lastRefill).Seconds()
+	l.lastRefill = now
+
+	l.tokens += elapsed * l.ratePerSec
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// unlimited never throttles; it's the default for a channel with no
+// configured RateLimiter.
+type unlimited struct{}
+
+func (unlimited) Allow() bool { return true }
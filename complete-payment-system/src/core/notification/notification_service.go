@@ -0,0 +1,322 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NotificationType defines the type of notification
+type NotificationType string
+
+const (
+	// NotificationTypeEmail represents an email notification
+	NotificationTypeEmail NotificationType = "email"
+
+	// NotificationTypeSMS represents an SMS notification
+	NotificationTypeSMS NotificationType = "sms"
+
+	// NotificationTypeWebhook represents a webhook notification
+	NotificationTypeWebhook NotificationType = "webhook"
+
+	// NotificationTypePush represents a push notification
+	NotificationTypePush NotificationType = "push"
+)
+
+// NotificationStatus defines the status of a notification
+type NotificationStatus string
+
+const (
+	// NotificationStatusPending represents a notification waiting to be
+	// picked up (or retried) by the Dispatcher
+	NotificationStatusPending NotificationStatus = "pending"
+
+	// NotificationStatusSent represents a sent notification
+	NotificationStatusSent NotificationStatus = "sent"
+
+	// NotificationStatusFailed represents a notification whose most
+	// recent send attempt failed but that has retries remaining
+	NotificationStatusFailed NotificationStatus = "failed"
+
+	// NotificationStatusDeadLetter represents a notification that
+	// exhausted MaxAttempts without succeeding
+	NotificationStatusDeadLetter NotificationStatus = "dead_letter"
+
+	// NotificationStatusCanceled represents a notification canceled
+	// before it was sent
+	NotificationStatusCanceled NotificationStatus = "canceled"
+)
+
+// Notification represents a notification to be sent
+type Notification struct {
+	ID            string                 `json:"id"`
+	Type          NotificationType       `json:"type"`
+	Recipient     string                 `json:"recipient"`
+	Subject       string                 `json:"subject,omitempty"`
+	Content       string                 `json:"content"`
+	Status        NotificationStatus     `json:"status"`
+	Attempts      int                    `json:"attempts"`
+	MaxAttempts   int                    `json:"max_attempts"`
+	NextAttemptAt time.Time              `json:"next_attempt_at"`
+	LastError     string                 `json:"last_error,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+	SentAt        time.Time              `json:"sent_at,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// defaultMaxAttempts caps retries for a notification that doesn't
+// specify its own limit.
+const defaultMaxAttempts = 5
+
+// webhookMaxAttempts overrides defaultMaxAttempts for webhook
+// notifications created by CreateTransactionNotification: a merchant's
+// endpoint being down for a while shouldn't cost them the delivery the
+// way a bounced email address should.
+const webhookMaxAttempts = 24
+
+// NotificationService enqueues notifications for delivery and answers
+// queries about their state. Delivery itself happens out of band in a
+// Dispatcher's worker pool, so SendNotification never blocks on a
+// downstream provider.
+type NotificationService struct {
+	Store      NotificationStore
+	Dispatcher *Dispatcher
+}
+
+// NewNotificationService creates a NotificationService backed by store,
+// with a Dispatcher wired up to deliver through clients.
+func NewNotificationService(store NotificationStore, clients Clients) *NotificationService {
+	return &NotificationService{
+		Store:      store,
+		Dispatcher: NewDispatcher(store, clients),
+	}
+}
+
+// SendNotification enqueues a notification for delivery and returns
+// immediately with its ID; the Dispatcher's worker pool sends it
+// asynchronously.
+func (s *NotificationService) SendNotification(notificationType NotificationType, recipient string, subject string, content string) (string, error) {
+	if recipient == "" || content == "" {
+		return "", errors.New("recipient and content are required")
+	}
+	switch notificationType {
+	case NotificationTypeEmail, NotificationTypeSMS, NotificationTypeWebhook, NotificationTypePush:
+	default:
+		return "", errors.New("unsupported notification type")
+	}
+
+	id := newNotificationID()
+	notification := Notification{
+		ID:            id,
+		Type:          notificationType,
+		Recipient:     recipient,
+		Subject:       subject,
+		Content:       content,
+		Status:        NotificationStatusPending,
+		MaxAttempts:   defaultMaxAttempts,
+		NextAttemptAt: time.Now(),
+		CreatedAt:     time.Now(),
+		Metadata:      make(map[string]interface{}),
+	}
+
+	if err := s.Store.Save(context.Background(), notification); err != nil {
+		return "", fmt.Errorf("notification: enqueuing notification: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetNotification retrieves a notification by ID
+func (s *NotificationService) GetNotification(id string) (*Notification, error) {
+	n, err := s.Store.Get(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// ListNotifications returns a cursor-paginated page of notifications
+// matching filter.
+func (s *NotificationService) ListNotifications(filter Filter) (Page, error) {
+	return s.Store.Query(context.Background(), filter)
+}
+
+// RetryNotification resets a failed or dead-lettered notification back
+// to pending with a fresh attempt budget, so the Dispatcher picks it up
+// on its next poll.
+func (s *NotificationService) RetryNotification(id string) error {
+	ctx := context.Background()
+	n, err := s.Store.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n.Status != NotificationStatusFailed && n.Status != NotificationStatusDeadLetter {
+		return fmt.Errorf("notification: %s is not in a retryable state (%s)", id, n.Status)
+	}
+
+	n.Status = NotificationStatusPending
+	n.Attempts = 0
+	n.LastError = ""
+	n.NextAttemptAt = time.Now()
+	return s.Store.Save(ctx, n)
+}
+
+// CancelNotification marks a pending or failed notification as
+// canceled, so the Dispatcher skips it.
+func (s *NotificationService) CancelNotification(id string) error {
+	ctx := context.Background()
+	n, err := s.Store.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n.Status == NotificationStatusSent {
+		return fmt.Errorf("notification: %s was already sent", id)
+	}
+	if n.Status == NotificationStatusCanceled {
+		return nil
+	}
+
+	n.Status = NotificationStatusCanceled
+	return s.Store.Save(ctx, n)
+}
+
+// newNotificationID generates an ID unique enough to double as a
+// webhook delivery's idempotency key (see CreateTransactionNotification
+// and webhook.IdempotencyKeyHeader).
+func newNotificationID() string {
+	return fmt.Sprintf("notification_%d", time.Now().UnixNano())
+}
+
+// transactionEvent is the JSON body sent to a merchant's CallbackURL
+// for a transaction status transition. EventID doubles as the
+// notification's idempotency key, so a receiver that sees it twice
+// (e.g. after a merchant-triggered replay) can deduplicate.
+type transactionEvent struct {
+	EventID       string    `json:"event_id"`
+	TransactionID string    `json:"transaction_id"`
+	Event         string    `json:"event"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// CreateTransactionNotification creates a notification for a
+// transaction status event (e.g. chain.ConfirmationTracker's Notifier
+// hook, called once per tracked status transition). recipient is
+// whatever the caller registered to be told about this transaction:
+// chain.ConfirmationTracker.Watch is given a PaymentRequest's
+// CallbackURL, so in practice recipient is almost always a webhook
+// URL, but a plain address/phone number is also accepted and falls
+// back to an email notification the way this method always used to
+// behave. merchantID records who owns this notification for
+// ReplayWebhook's ownership check below; pass "" when the caller has no
+// merchant concept (e.g. package callback's gateway-level handler) —
+// such a notification simply can't be replayed through that check.
+func (s *NotificationService) CreateTransactionNotification(transactionID string, event string, recipient string, merchantID string) (string, error) {
+	if strings.HasPrefix(recipient, "http://") || strings.HasPrefix(recipient, "https://") {
+		id := newNotificationID()
+		payload, err := json.Marshal(transactionEvent{
+			EventID:       id,
+			TransactionID: transactionID,
+			Event:         event,
+			CreatedAt:     time.Now(),
+		})
+		if err != nil {
+			return "", fmt.Errorf("notification: encoding webhook event: %w", err)
+		}
+
+		notification := Notification{
+			ID:            id,
+			Type:          NotificationTypeWebhook,
+			Recipient:     recipient,
+			Subject:       "transaction." + event,
+			Content:       string(payload),
+			Status:        NotificationStatusPending,
+			MaxAttempts:   webhookMaxAttempts,
+			NextAttemptAt: time.Now(),
+			CreatedAt:     time.Now(),
+			Metadata:      map[string]interface{}{"transaction_id": transactionID, "merchant_id": merchantID},
+		}
+		if err := s.Store.Save(context.Background(), notification); err != nil {
+			return "", fmt.Errorf("notification: enqueuing webhook notification: %w", err)
+		}
+		return id, nil
+	}
+
+	subject := "Transaction " + event
+	content := "Your transaction " + transactionID + " has been " + event
+	return s.SendNotification(NotificationTypeEmail, recipient, subject, content)
+}
+
+// maxReplayScanPages bounds how many pages ReplayWebhook will read from
+// the store looking for transactionID's deliveries. NotificationStore's
+// Filter has no transaction-scoped index (metadata is an opaque blob to
+// every backend, SQLStore included), so this scan is inherently
+// O(webhook notifications in the store) rather than O(matches); capping
+// it keeps one replay request from an unbounded full-table scan. 50
+// pages of 100 is 5,000 notifications, comfortably past what any single
+// transaction's retry history should ever produce.
+const maxReplayScanPages = 50
+
+// ReplayWebhook resets every webhook notification recorded against
+// transactionID for merchantID back to pending, regardless of its
+// current status, so the Dispatcher redelivers it on its next poll.
+// Unlike RetryNotification (which only accepts an already-failed
+// notification's ID), this is meant for a merchant who simply missed a
+// delivery they received a 2xx for, or never registered a listener in
+// time for, and now wants every event for a transaction resent.
+// merchantID must match the notification's recorded owner (see
+// CreateTransactionNotification) — a notification with no recorded
+// owner (merchantID "") can never be replayed this way, including by a
+// caller that itself passes "".
+func (s *NotificationService) ReplayWebhook(transactionID string, merchantID string) (int, error) {
+	if merchantID == "" {
+		return 0, fmt.Errorf("notification: merchantID is required to replay webhooks for %s", transactionID)
+	}
+
+	ctx := context.Background()
+	replayed := 0
+	var after Cursor
+
+	for page := 0; page < maxReplayScanPages; page++ {
+		result, err := s.Store.Query(ctx, Filter{Type: NotificationTypeWebhook, Limit: 100, After: after})
+		if err != nil {
+			return replayed, fmt.Errorf("notification: listing webhook notifications: %w", err)
+		}
+
+		for _, n := range result.Items {
+			if txID, _ := n.Metadata["transaction_id"].(string); txID != transactionID {
+				continue
+			}
+			if owner, _ := n.Metadata["merchant_id"].(string); owner == "" || owner != merchantID {
+				continue
+			}
+			if n.Status == NotificationStatusPending {
+				continue // already queued for delivery
+			}
+
+			n.Status = NotificationStatusPending
+			n.Attempts = 0
+			n.LastError = ""
+			n.NextAttemptAt = time.Now()
+			if err := s.Store.Save(ctx, n); err != nil {
+				continue
+			}
+			replayed++
+		}
+
+		if !result.HasMore {
+			if replayed == 0 {
+				return 0, fmt.Errorf("notification: no webhook deliveries found for transaction %s", transactionID)
+			}
+			return replayed, nil
+		}
+		after = result.NextCursor
+	}
+
+	if replayed == 0 {
+		return 0, fmt.Errorf("notification: scanned %d pages without finding transaction %s's webhook deliveries; it may have more history than this replay endpoint scans", maxReplayScanPages, transactionID)
+	}
+	return replayed, nil
+}
@@ -0,0 +1,86 @@
+package notification
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cursor opaquely identifies a position within a paginated Query
+// result, following the same keyset-pagination convention as
+// storage.Cursor: empty means "start from the beginning" (as
+// Filter.After) or "no more pages" (as returned from Query).
+type Cursor string
+
+// Filter narrows a Query call against a NotificationStore.
+type Filter struct {
+	Status NotificationStatus
+	Type   NotificationType
+	From   time.Time
+	To     time.Time
+	Limit  int
+	After  Cursor
+}
+
+// Page is the result of a Query: a page of notifications plus
+// pagination state.
+type Page struct {
+	Items      []Notification
+	NextCursor Cursor
+	HasMore    bool
+}
+
+// NotificationStore persists notifications and lets the Dispatcher find
+// the ones due for a delivery attempt.
+type NotificationStore interface {
+	Save(ctx context.Context, n Notification) error
+	Get(ctx context.Context, id string) (Notification, error)
+	Query(ctx context.Context, filter Filter) (Page, error)
+
+	// DuePending returns up to limit pending/failed notifications whose
+	// NextAttemptAt has elapsed, for the Dispatcher to attempt.
+	DuePending(ctx context.Context, limit int) ([]Notification, error)
+}
+
+// encodeCursor builds an opaque Cursor from the last row's sort key
+// (created_at, id), for keyset pagination.
+func encodeCursor(createdAt time.Time, id string) Cursor {
+	raw := fmt.Sprintf("%d:%s", createdAt.UnixNano(), id)
+	return Cursor(base64.RawURLEncoding.EncodeToString([]byte(raw)))
+}
+
+// decodeCursor recovers the sort key encoded by encodeCursor.
+func decodeCursor(c Cursor) (createdAt time.Time, id string, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(string(c))
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("notification: invalid cursor: %w", err)
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return time.Time{}, "", fmt.Errorf("notification: malformed cursor")
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("notification: malformed cursor timestamp: %w", err)
+	}
+	return time.Unix(0, nanos), parts[1], nil
+}
+
+// normalizeLimit applies the default and cap used by every paginated
+// query in this package.
+func normalizeLimit(limit int) int {
+	const (
+		defaultLimit = 100
+		maxLimit     = 1000
+	)
+	if limit <= 0 {
+		return defaultLimit
+	}
+	if limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
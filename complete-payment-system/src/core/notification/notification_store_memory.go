@@ -0,0 +1,125 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-memory NotificationStore, suitable for tests and
+// single-process deployments.
+type MemoryStore struct {
+	mu            sync.Mutex
+	notifications map[string]Notification
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{notifications: make(map[string]Notification)}
+}
+
+// Save implements NotificationStore.
+func (s *MemoryStore) Save(ctx context.Context, n Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notifications[n.ID] = n
+	return nil
+}
+
+// Get implements NotificationStore.
+func (s *MemoryStore) Get(ctx context.Context, id string) (Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, ok := s.notifications[id]
+	if !ok {
+		return Notification{}, fmt.Errorf("notification: %s not found", id)
+	}
+	return n, nil
+}
+
+// Query implements NotificationStore.
+func (s *MemoryStore) Query(ctx context.Context, filter Filter) (Page, error) {
+	s.mu.Lock()
+	all := make([]Notification, 0, len(s.notifications))
+	for _, n := range s.notifications {
+		all = append(all, n)
+	}
+	s.mu.Unlock()
+
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].CreatedAt.Equal(all[j].CreatedAt) {
+			return all[i].ID < all[j].ID
+		}
+		return all[i].CreatedAt.Before(all[j].CreatedAt)
+	})
+
+	var afterTime time.Time
+	var afterID string
+	if filter.After != "" {
+		var err error
+		afterTime, afterID, err = decodeCursor(filter.After)
+		if err != nil {
+			return Page{}, err
+		}
+	}
+
+	limit := normalizeLimit(filter.Limit)
+	matched := make([]Notification, 0, limit+1)
+	for _, n := range all {
+		if filter.Status != "" && n.Status != filter.Status {
+			continue
+		}
+		if filter.Type != "" && n.Type != filter.Type {
+			continue
+		}
+		if !filter.From.IsZero() && n.CreatedAt.Before(filter.From) {
+			continue
+		}
+		if !filter.To.IsZero() && n.CreatedAt.After(filter.To) {
+			continue
+		}
+		if filter.After != "" {
+			if n.CreatedAt.Before(afterTime) || (n.CreatedAt.Equal(afterTime) && n.ID <= afterID) {
+				continue
+			}
+		}
+		matched = append(matched, n)
+		if len(matched) > limit {
+			break
+		}
+	}
+
+	var next Cursor
+	if len(matched) > limit {
+		last := matched[limit-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+		matched = matched[:limit]
+	}
+	return Page{Items: matched, NextCursor: next, HasMore: next != ""}, nil
+}
+
+// DuePending implements NotificationStore.
+func (s *MemoryStore) DuePending(ctx context.Context, limit int) ([]Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	due := make([]Notification, 0, limit)
+	for _, n := range s.notifications {
+		if n.Status != NotificationStatusPending && n.Status != NotificationStatusFailed {
+			continue
+		}
+		if n.NextAttemptAt.After(now) {
+			continue
+		}
+		due = append(due, n)
+	}
+
+	sort.Slice(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(due[j].NextAttemptAt) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
@@ -0,0 +1,233 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// notificationSchema bootstraps the table SQLStore needs. Unlike the
+// transaction/compliance_event tables in package storage, the
+// notification queue has no cross-backend schema history to track yet,
+// so a plain CREATE TABLE IF NOT EXISTS is enough rather than a full
+// migration set.
+const notificationSchema = `
+CREATE TABLE IF NOT EXISTS notifications (
+	id              TEXT PRIMARY KEY,
+	type            TEXT NOT NULL,
+	recipient       TEXT NOT NULL,
+	subject         TEXT,
+	content         TEXT NOT NULL,
+	status          TEXT NOT NULL,
+	attempts        INTEGER NOT NULL DEFAULT 0,
+	max_attempts    INTEGER NOT NULL DEFAULT 5,
+	next_attempt_at DATETIME NOT NULL,
+	last_error      TEXT,
+	metadata        TEXT,
+	created_at      DATETIME NOT NULL,
+	sent_at         DATETIME
+)`
+
+// SQLStore is a database/sql-backed NotificationStore, suitable for any
+// driver that accepts "?" positional placeholders (e.g. SQLite,
+// MySQL) — the same embedded/dev role SQLiteStore plays in package
+// storage.
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore opens (creating if necessary) a SQLite database at path
+// and creates the notifications table if it doesn't already exist.
+func NewSQLStore(ctx context.Context, path string) (*SQLStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("notification: opening database: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, notificationSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("notification: creating schema: %w", err)
+	}
+	return &SQLStore{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (s *SQLStore) Close() error {
+	return s.db.Close()
+}
+
+// Save implements NotificationStore.
+func (s *SQLStore) Save(ctx context.Context, n Notification) error {
+	var sentAt interface{}
+	if !n.SentAt.IsZero() {
+		sentAt = n.SentAt
+	}
+
+	var metadata interface{}
+	if len(n.Metadata) > 0 {
+		encoded, err := json.Marshal(n.Metadata)
+		if err != nil {
+			return fmt.Errorf("notification: encoding metadata for %s: %w", n.ID, err)
+		}
+		metadata = string(encoded)
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO notifications (
+			id, type, recipient, subject, content, status, attempts, max_attempts,
+			next_attempt_at, last_error, metadata, created_at, sent_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			status = excluded.status,
+			attempts = excluded.attempts,
+			next_attempt_at = excluded.next_attempt_at,
+			last_error = excluded.last_error,
+			metadata = excluded.metadata,
+			sent_at = excluded.sent_at
+	`,
+		n.ID, n.Type, n.Recipient, n.Subject, n.Content, n.Status, n.Attempts, n.MaxAttempts,
+		n.NextAttemptAt, n.LastError, metadata, n.CreatedAt, sentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("notification: saving %s: %w", n.ID, err)
+	}
+	return nil
+}
+
+// Get implements NotificationStore.
+func (s *SQLStore) Get(ctx context.Context, id string) (Notification, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT id, type, recipient, subject, content, status, attempts, max_attempts,
+			next_attempt_at, last_error, metadata, created_at, sent_at
+		FROM notifications WHERE id = ?
+	`, id)
+	return scanNotification(row)
+}
+
+// Query implements NotificationStore.
+func (s *SQLStore) Query(ctx context.Context, filter Filter) (Page, error) {
+	limit := normalizeLimit(filter.Limit)
+
+	query := `SELECT id, type, recipient, subject, content, status, attempts, max_attempts,
+		next_attempt_at, last_error, metadata, created_at, sent_at
+		FROM notifications WHERE 1=1`
+	args := []interface{}{}
+
+	if filter.Status != "" {
+		query += " AND status = ?"
+		args = append(args, filter.Status)
+	}
+	if filter.Type != "" {
+		query += " AND type = ?"
+		args = append(args, filter.Type)
+	}
+	if !filter.From.IsZero() {
+		query += " AND created_at >= ?"
+		args = append(args, filter.From)
+	}
+	if !filter.To.IsZero() {
+		query += " AND created_at <= ?"
+		args = append(args, filter.To)
+	}
+	if filter.After != "" {
+		afterTime, afterID, err := decodeCursor(filter.After)
+		if err != nil {
+			return Page{}, err
+		}
+		query += " AND (created_at, id) > (?, ?)"
+		args = append(args, afterTime, afterID)
+	}
+
+	query += " ORDER BY created_at, id LIMIT ?"
+	args = append(args, limit+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return Page{}, fmt.Errorf("notification: querying notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var results []Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return Page{}, err
+		}
+		results = append(results, n)
+	}
+	if err := rows.Err(); err != nil {
+		return Page{}, err
+	}
+
+	var next Cursor
+	if len(results) > limit {
+		last := results[limit-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+		results = results[:limit]
+	}
+	return Page{Items: results, NextCursor: next, HasMore: next != ""}, nil
+}
+
+// DuePending implements NotificationStore.
+func (s *SQLStore) DuePending(ctx context.Context, limit int) ([]Notification, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, type, recipient, subject, content, status, attempts, max_attempts,
+			next_attempt_at, last_error, metadata, created_at, sent_at
+		FROM notifications
+		WHERE status IN (?, ?) AND next_attempt_at <= ?
+		ORDER BY next_attempt_at
+		LIMIT ?
+	`, NotificationStatusPending, NotificationStatusFailed, time.Now(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("notification: querying due notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var results []Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, n)
+	}
+	return results, rows.Err()
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanNotification(row rowScanner) (Notification, error) {
+	var (
+		n         Notification
+		subject   sql.NullString
+		lastError sql.NullString
+		metadata  sql.NullString
+		sentAt    sql.NullTime
+	)
+	if err := row.Scan(
+		&n.ID, &n.Type, &n.Recipient, &subject, &n.Content, &n.Status, &n.Attempts, &n.MaxAttempts,
+		&n.NextAttemptAt, &lastError, &metadata, &n.CreatedAt, &sentAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return Notification{}, fmt.Errorf("notification: not found")
+		}
+		return Notification{}, err
+	}
+	n.Subject = subject.String
+	n.LastError = lastError.String
+	if sentAt.Valid {
+		n.SentAt = sentAt.Time
+	}
+	if metadata.Valid && metadata.String != "" {
+		if err := json.Unmarshal([]byte(metadata.String), &n.Metadata); err != nil {
+			return Notification{}, fmt.Errorf("notification: decoding metadata for %s: %w", n.ID, err)
+		}
+	}
+	return n, nil
+}
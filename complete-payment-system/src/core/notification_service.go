@@ -1,170 +0,0 @@
-package notification
-
-import (
-	"errors"
-	"time"
-)
-
-// NotificationType defines the type of notification
-type NotificationType string
-
-const (
-	// NotificationTypeEmail represents an email notification
-	NotificationTypeEmail NotificationType = "email"
-	
-	// NotificationTypeSMS represents an SMS notification
-	NotificationTypeSMS NotificationType = "sms"
-	
-	// NotificationTypeWebhook represents a webhook notification
-	NotificationTypeWebhook NotificationType = "webhook"
-	
-	// NotificationTypePush represents a push notification
-	NotificationTypePush NotificationType = "push"
-)
-
-// NotificationStatus defines the status of a notification
-type NotificationStatus string
-
-const (
-	// NotificationStatusPending represents a pending notification
-	NotificationStatusPending NotificationStatus = "pending"
-	
-	// NotificationStatusSent represents a sent notification
-	NotificationStatusSent NotificationStatus = "sent"
-	
-	// NotificationStatusFailed represents a failed notification
-	NotificationStatusFailed NotificationStatus = "failed"
-)
-
-// Notification represents a notification to be sent
-type Notification struct {
-	ID          string             `json:"id"`
-	Type        NotificationType   `json:"type"`
-	Recipient   string             `json:"recipient"`
-	Subject     string             `json:"subject,omitempty"`
-	Content     string             `json:"content"`
-	Status      NotificationStatus `json:"status"`
-	CreatedAt   time.Time          `json:"created_at"`
-	SentAt      time.Time          `json:"sent_at,omitempty"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
-}
-
-// NotificationService handles notification operations
-type NotificationService struct {
-	// Dependencies would be injected here in a real implementation
-	// emailClient, smsClient, etc.
-}
-
-// NewNotificationService creates a new instance of NotificationService
-func NewNotificationService() *NotificationService {
-	return &NotificationService{}
-}
-
-// SendNotification sends a notification
-func (s *NotificationService) SendNotification(notificationType NotificationType, recipient string, subject string, content string) (string, error) {
-	if recipient == "" || content == "" {
-		return "", errors.New("recipient and content are required")
-	}
-	
-	// Generate a notification ID
-	id := "notification_" + time.Now().Format("20060102150405")
-	
-	// Create a notification
-	notification := &Notification{
-		ID:        id,
-		Type:      notificationType,
-		Recipient: recipient,
-		Subject:   subject,
-		Content:   content,
-		Status:    NotificationStatusPending,
-		CreatedAt: time.Now(),
-		Metadata:  make(map[string]interface{}),
-	}
-	
-	// In a real implementation, we would:
-	// 1. Persist the notification to a database
-	// 2. Send the notification using the appropriate client
-	// 3. Update the notification status
-	
-	// For now, we'll simulate sending the notification
-	var err error
-	switch notificationType {
-	case NotificationTypeEmail:
-		err = s.sendEmail(notification)
-	case NotificationTypeSMS:
-		err = s.sendSMS(notification)
-	case NotificationTypeWebhook:
-		err = s.sendWebhook(notification)
-	case NotificationTypePush:
-		err = s.sendPush(notification)
-	default:
-		return "", errors.New("unsupported notification type")
-	}
-	
-	if err != nil {
-		// In a real implementation, we would update the notification status to failed
-		return "", err
-	}
-	
-	// In a real implementation, we would update the notification status to sent
-	notification.Status = NotificationStatusSent
-	notification.SentAt = time.Now()
-	
-	return id, nil
-}
-
-// GetNotification retrieves a notification by ID
-func (s *NotificationService) GetNotification(id string) (*Notification, error) {
-	// In a real implementation, we would retrieve the notification from the database
-	// For now, we'll return an error
-	return nil, errors.New("notification not found")
-}
-
-// ListNotifications retrieves notifications based on filters
-func (s *NotificationService) ListNotifications(status NotificationStatus, startTime, endTime time.Time) ([]*Notification, error) {
-	// In a real implementation, we would query the database with the provided filters
-	// For now, we'll return an empty slice
-	return []*Notification{}, nil
-}
-
-// sendEmail sends an email notification
-func (s *NotificationService) sendEmail(notification *Notification) error {
-	// In a real implementation, we would use an email client to send the email
-	// For now, we'll return nil to simulate success
-	return nil
-}
-
-// sendSMS sends an SMS notification
-func (s *NotificationService) sendSMS(notification *Notification) error {
-	// In a real implementation, we would use an SMS client to send the SMS
-	// For now, we'll return nil to simulate success
-	return nil
-}
-
-// sendWebhook sends a webhook notification
-func (s *NotificationService) sendWebhook(notification *Notification) error {
-	// In a real implementation, we would make an HTTP request to the webhook URL
-	// For now, we'll return nil to simulate success
-	return nil
-}
-
-// sendPush sends a push notification
-func (s *NotificationService) sendPush(notification *Notification) error {
-	// In a real implementation, we would use a push notification service
-	// For now, we'll return nil to simulate success
-	return nil
-}
-
-// CreateTransactionNotification creates a notification for a transaction event
-func (s *NotificationService) CreateTransactionNotification(transactionID string, event string, recipient string) (string, error) {
-	// In a real implementation, we would:
-	// 1. Retrieve the transaction details
-	// 2. Format the notification content based on the event type
-	// 3. Send the notification
-	
-	// For now, we'll create a simple notification
-	subject := "Transaction " + event
-	content := "Your transaction " + transactionID + " has been " + event
-	
-	return s.SendNotification(NotificationTypeEmail, recipient, subject, content)
-}
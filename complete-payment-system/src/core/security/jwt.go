@@ -0,0 +1,531 @@
+package security
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// clockSkew is how far claims' exp/nbf may disagree with the local
+// clock before VerifyJWT rejects a token, to tolerate drift between
+// the issuing and verifying hosts.
+const clockSkew = 60 * time.Second
+
+// KeyAlgorithm identifies how a Key signs and verifies tokens.
+type KeyAlgorithm string
+
+// Supported signing algorithms. "none" is deliberately not offered:
+// accepting it would let a caller forge an unsigned token.
+const (
+	AlgHS256 KeyAlgorithm = "HS256"
+	AlgRS256 KeyAlgorithm = "RS256"
+)
+
+// Key is one entry in a Keyset. HMACSecret is set for AlgHS256;
+// RSAPrivateKey/RSAPublicKey are set for AlgRS256. A Key used only to
+// verify (e.g. a rotated-out signing key still accepted for a grace
+// period) may omit the private half.
+type Key struct {
+	ID            string
+	Algorithm     KeyAlgorithm
+	HMACSecret    []byte
+	RSAPrivateKey *rsa.PrivateKey
+	RSAPublicKey  *rsa.PublicKey
+}
+
+// Keyset is a JWKS-style, rotation-capable collection of signing and
+// verification keys, keyed by "kid". GenerateJWT signs with the active
+// key; VerifyJWT looks up whichever kid the token's header names, so a
+// token signed before a rotation still verifies until its key is
+// explicitly removed.
+type Keyset struct {
+	mu       sync.RWMutex
+	keys     map[string]Key
+	activeID string
+}
+
+// NewKeyset creates an empty Keyset. Call AddKey at least once before
+// GenerateJWT; the first key added becomes active.
+func NewKeyset() *Keyset {
+	return &Keyset{keys: make(map[string]Key)}
+}
+
+// AddKey registers key and, if it is the first key or makeActive is
+// true, makes it the active signing key.
+func (ks *Keyset) AddKey(key Key, makeActive bool) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.keys[key.ID] = key
+	if makeActive || ks.activeID == "" {
+		ks.activeID = key.ID
+	}
+}
+
+// Revoke removes kid from the keyset. Tokens signed with it stop
+// verifying immediately; if kid was active, no key is active until
+// AddKey is called again.
+func (ks *Keyset) Revoke(kid string) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	delete(ks.keys, kid)
+	if ks.activeID == kid {
+		ks.activeID = ""
+	}
+}
+
+// Get returns the key registered under kid.
+func (ks *Keyset) Get(kid string) (Key, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// Active returns the current signing key.
+func (ks *Keyset) Active() (Key, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	if ks.activeID == "" {
+		return Key{}, false
+	}
+	key, ok := ks.keys[ks.activeID]
+	return key, ok
+}
+
+// RevocationStore tracks tokens ("jti" claims) that must be rejected
+// before their natural expiry, e.g. on logout or credential
+// compromise. Mirrors the pluggable-store shape notification.Store
+// uses: an in-memory default for local/dev use, with room for a
+// Redis- or SQL-backed implementation in production.
+type RevocationStore interface {
+	Revoke(jti string, expiresAt time.Time) error
+	IsRevoked(jti string) (bool, error)
+}
+
+// MemoryRevocationStore is an in-process RevocationStore. It does not
+// survive a restart and does not share state across instances; use a
+// shared backend (Redis, SQL) for a multi-instance deployment.
+type MemoryRevocationStore struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time
+}
+
+// NewMemoryRevocationStore creates an empty MemoryRevocationStore.
+func NewMemoryRevocationStore() *MemoryRevocationStore {
+	return &MemoryRevocationStore{revoked: make(map[string]time.Time)}
+}
+
+// Revoke implements RevocationStore.
+func (m *MemoryRevocationStore) Revoke(jti string, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.revoked[jti] = expiresAt
+	return nil
+}
+
+// IsRevoked implements RevocationStore. An entry is forgotten once its
+// expiresAt has passed, since the token it describes would be rejected
+// for expiry anyway.
+func (m *MemoryRevocationStore) IsRevoked(jti string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	expiresAt, ok := m.revoked[jti]
+	if !ok {
+		return false, nil
+	}
+	if time.Now().After(expiresAt) {
+		delete(m.revoked, jti)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Claims holds a JWT's registered claims plus any merchant-specific
+// custom claims. Custom claims are merged into the same top-level JSON
+// object as the registered ones (as the JWT spec requires), not nested
+// under a sub-key.
+type Claims struct {
+	Issuer    string
+	Subject   string
+	Audience  string
+	ExpiresAt time.Time
+	NotBefore time.Time
+	IssuedAt  time.Time
+	ID        string
+	Custom    map[string]interface{}
+}
+
+// NewClaims builds the Claims for a token issued by issuer to subject,
+// valid for ttl, with a freshly generated jti.
+func NewClaims(issuer, subject, audience string, ttl time.Duration) (Claims, error) {
+	jti, err := newJTI()
+	if err != nil {
+		return Claims{}, err
+	}
+	now := time.Now()
+	return Claims{
+		Issuer:    issuer,
+		Subject:   subject,
+		Audience:  audience,
+		IssuedAt:  now,
+		NotBefore: now,
+		ExpiresAt: now.Add(ttl),
+		ID:        jti,
+	}, nil
+}
+
+// MarshalJSON merges the registered claims with Custom into one flat
+// object, the shape the JWT spec requires and the same approach
+// money.Amount uses for its own non-struct-shaped JSON encoding.
+func (c Claims) MarshalJSON() ([]byte, error) {
+	out := make(map[string]interface{}, len(c.Custom)+7)
+	for k, v := range c.Custom {
+		out[k] = v
+	}
+	if c.Issuer != "" {
+		out["iss"] = c.Issuer
+	}
+	if c.Subject != "" {
+		out["sub"] = c.Subject
+	}
+	if c.Audience != "" {
+		out["aud"] = c.Audience
+	}
+	if !c.ExpiresAt.IsZero() {
+		out["exp"] = c.ExpiresAt.Unix()
+	}
+	if !c.NotBefore.IsZero() {
+		out["nbf"] = c.NotBefore.Unix()
+	}
+	if !c.IssuedAt.IsZero() {
+		out["iat"] = c.IssuedAt.Unix()
+	}
+	if c.ID != "" {
+		out["jti"] = c.ID
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON splits a flat claims object back into the registered
+// fields plus whatever is left over as Custom.
+func (c *Claims) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	take := func(key string) (interface{}, bool) {
+		v, ok := raw[key]
+		if ok {
+			delete(raw, key)
+		}
+		return v, ok
+	}
+	if v, ok := take("iss"); ok {
+		c.Issuer, _ = v.(string)
+	}
+	if v, ok := take("sub"); ok {
+		c.Subject, _ = v.(string)
+	}
+	if v, ok := take("aud"); ok {
+		c.Audience, _ = v.(string)
+	}
+	if v, ok := take("exp"); ok {
+		n, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("security: claim \"exp\" is not a number")
+		}
+		c.ExpiresAt = time.Unix(int64(n), 0)
+	}
+	if v, ok := take("nbf"); ok {
+		n, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("security: claim \"nbf\" is not a number")
+		}
+		c.NotBefore = time.Unix(int64(n), 0)
+	}
+	if v, ok := take("iat"); ok {
+		n, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("security: claim \"iat\" is not a number")
+		}
+		c.IssuedAt = time.Unix(int64(n), 0)
+	}
+	if v, ok := take("jti"); ok {
+		c.ID, _ = v.(string)
+	}
+	c.Custom = raw
+	return nil
+}
+
+// jwtHeader is the JOSE header. Kid names which Keyset entry signed
+// the token, so VerifyJWT can find the matching key without trying
+// every one it knows about.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid,omitempty"`
+}
+
+// GenerateJWT signs claims with kid (or the keyset's active key if kid
+// is empty) and returns the compact header.payload.signature token.
+func (s *SecurityService) GenerateJWT(claims Claims, kid string) (string, error) {
+	if s.Keys == nil {
+		return "", errors.New("security: no keyset configured")
+	}
+	var key Key
+	var ok bool
+	if kid == "" {
+		key, ok = s.Keys.Active()
+	} else {
+		key, ok = s.Keys.Get(kid)
+	}
+	if !ok {
+		return "", fmt.Errorf("security: no signing key %q", kid)
+	}
+	if claims.Issuer == "" {
+		claims.Issuer = s.Issuer
+	}
+
+	headerJSON, err := json.Marshal(jwtHeader{Alg: string(key.Algorithm), Typ: "JWT", Kid: key.ID})
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64URLEncode(headerJSON) + "." + base64URLEncode(claimsJSON)
+
+	signature, err := sign(key, []byte(signingInput))
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64URLEncode(signature), nil
+}
+
+// VerifyJWT verifies token's signature, expiry/not-before window (with
+// clockSkew tolerance), and revocation status, returning its claims as
+// a plain map so callers outside this package don't need to import it
+// to use the result.
+func (s *SecurityService) VerifyJWT(token string) (bool, map[string]interface{}, error) {
+	if s.Keys == nil {
+		return false, nil, errors.New("security: no keyset configured")
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false, nil, errors.New("security: malformed token")
+	}
+	headerJSON, err := base64URLDecode(parts[0])
+	if err != nil {
+		return false, nil, fmt.Errorf("security: malformed header: %w", err)
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return false, nil, fmt.Errorf("security: malformed header: %w", err)
+	}
+	if header.Kid == "" {
+		return false, nil, errors.New("security: token header has no kid")
+	}
+	key, ok := s.Keys.Get(header.Kid)
+	if !ok {
+		return false, nil, fmt.Errorf("security: unknown signing key %q", header.Kid)
+	}
+	// Require the header's declared algorithm to match the key's own
+	// algorithm rather than trusting the header outright, so a token
+	// can't switch e.g. RS256 to HS256 and "sign" with the public key.
+	if header.Alg != string(key.Algorithm) {
+		return false, nil, fmt.Errorf("security: token alg %q does not match key %q's algorithm %q", header.Alg, key.ID, key.Algorithm)
+	}
+
+	signature, err := base64URLDecode(parts[2])
+	if err != nil {
+		return false, nil, fmt.Errorf("security: malformed signature: %w", err)
+	}
+	signingInput := parts[0] + "." + parts[1]
+	if err := verify(key, []byte(signingInput), signature); err != nil {
+		return false, nil, err
+	}
+
+	claimsJSON, err := base64URLDecode(parts[1])
+	if err != nil {
+		return false, nil, fmt.Errorf("security: malformed claims: %w", err)
+	}
+	var claims Claims
+	if err := json.Unmarshal(claimsJSON, &claims); err != nil {
+		return false, nil, fmt.Errorf("security: malformed claims: %w", err)
+	}
+
+	now := time.Now()
+	if !claims.ExpiresAt.IsZero() && now.After(claims.ExpiresAt.Add(clockSkew)) {
+		return false, nil, errors.New("security: token expired")
+	}
+	if !claims.NotBefore.IsZero() && now.Before(claims.NotBefore.Add(-clockSkew)) {
+		return false, nil, errors.New("security: token not yet valid")
+	}
+
+	if s.Revocations != nil && claims.ID != "" {
+		revoked, err := s.Revocations.IsRevoked(claims.ID)
+		if err != nil {
+			return false, nil, fmt.Errorf("security: checking revocation: %w", err)
+		}
+		if revoked {
+			return false, nil, errors.New("security: token has been revoked")
+		}
+	}
+
+	claimsMap := make(map[string]interface{})
+	if err := json.Unmarshal(claimsJSON, &claimsMap); err != nil {
+		return false, nil, err
+	}
+	return true, claimsMap, nil
+}
+
+// RevokeJWT marks token's jti as revoked until its own expiry, so it
+// stops verifying immediately instead of waiting out its remaining
+// lifetime. It does not re-verify the signature first: a caller should
+// only revoke a token it has already authenticated (e.g. on logout).
+func (s *SecurityService) RevokeJWT(claims map[string]interface{}) error {
+	if s.Revocations == nil {
+		return errors.New("security: no revocation store configured")
+	}
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return errors.New("security: claims have no jti to revoke")
+	}
+	expiresAt := time.Now().Add(24 * time.Hour)
+	if exp, ok := claims["exp"].(float64); ok {
+		expiresAt = time.Unix(int64(exp), 0)
+	}
+	return s.Revocations.Revoke(jti, expiresAt)
+}
+
+// tokenTypeClaim is the custom claim distinguishing a refresh token
+// from an access token, since both are ordinary JWTs signed by the
+// same keyset.
+const tokenTypeClaim = "token_type"
+
+// GenerateTokenPair issues an access token (accessTTL) and a refresh
+// token (refreshTTL) for subject/audience, both signed with kid (or
+// the active key if kid is empty).
+func (s *SecurityService) GenerateTokenPair(subject, audience, kid string, accessTTL, refreshTTL time.Duration) (access, refresh string, err error) {
+	accessClaims, err := NewClaims(s.Issuer, subject, audience, accessTTL)
+	if err != nil {
+		return "", "", err
+	}
+	accessClaims.Custom = map[string]interface{}{tokenTypeClaim: "access"}
+	access, err = s.GenerateJWT(accessClaims, kid)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshClaims, err := NewClaims(s.Issuer, subject, audience, refreshTTL)
+	if err != nil {
+		return "", "", err
+	}
+	refreshClaims.Custom = map[string]interface{}{tokenTypeClaim: "refresh"}
+	refresh, err = s.GenerateJWT(refreshClaims, kid)
+	if err != nil {
+		return "", "", err
+	}
+	return access, refresh, nil
+}
+
+// RefreshAccessToken verifies refreshToken and, if it is a valid,
+// unrevoked refresh token, issues a new access token for the same
+// subject/audience, signed with kid (or the active key if kid is
+// empty).
+func (s *SecurityService) RefreshAccessToken(refreshToken, kid string, accessTTL time.Duration) (string, error) {
+	valid, claims, err := s.VerifyJWT(refreshToken)
+	if err != nil {
+		return "", err
+	}
+	if !valid {
+		return "", errors.New("security: invalid refresh token")
+	}
+	if claims[tokenTypeClaim] != "refresh" {
+		return "", errors.New("security: token is not a refresh token")
+	}
+	subject, _ := claims["sub"].(string)
+	audience, _ := claims["aud"].(string)
+
+	accessClaims, err := NewClaims(s.Issuer, subject, audience, accessTTL)
+	if err != nil {
+		return "", err
+	}
+	accessClaims.Custom = map[string]interface{}{tokenTypeClaim: "access"}
+	return s.GenerateJWT(accessClaims, kid)
+}
+
+// sign computes key's signature over data.
+func sign(key Key, data []byte) ([]byte, error) {
+	switch key.Algorithm {
+	case AlgHS256:
+		if len(key.HMACSecret) == 0 {
+			return nil, fmt.Errorf("security: key %q has no HMAC secret", key.ID)
+		}
+		h := hmac.New(sha256.New, key.HMACSecret)
+		h.Write(data)
+		return h.Sum(nil), nil
+	case AlgRS256:
+		if key.RSAPrivateKey == nil {
+			return nil, fmt.Errorf("security: key %q has no RSA private key", key.ID)
+		}
+		digest := sha256.Sum256(data)
+		return rsa.SignPKCS1v15(rand.Reader, key.RSAPrivateKey, crypto.SHA256, digest[:])
+	default:
+		return nil, fmt.Errorf("security: unsupported signing algorithm %q", key.Algorithm)
+	}
+}
+
+// verify checks signature against data under key.
+func verify(key Key, data, signature []byte) error {
+	switch key.Algorithm {
+	case AlgHS256:
+		if len(key.HMACSecret) == 0 {
+			return fmt.Errorf("security: key %q has no HMAC secret", key.ID)
+		}
+		h := hmac.New(sha256.New, key.HMACSecret)
+		h.Write(data)
+		if !hmac.Equal(h.Sum(nil), signature) {
+			return errors.New("security: invalid token signature")
+		}
+		return nil
+	case AlgRS256:
+		if key.RSAPublicKey == nil {
+			return fmt.Errorf("security: key %q has no RSA public key", key.ID)
+		}
+		digest := sha256.Sum256(data)
+		if err := rsa.VerifyPKCS1v15(key.RSAPublicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return errors.New("security: invalid token signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("security: unsupported signing algorithm %q", key.Algorithm)
+	}
+}
+
+// newJTI generates a random token identifier for the "jti" claim.
+func newJTI() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64URLEncode(buf), nil
+}
+
+func base64URLEncode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func base64URLDecode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
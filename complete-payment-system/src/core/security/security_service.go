@@ -8,17 +8,30 @@ import (
 	"encoding/base64"
 	"errors"
 	"io"
-	"time"
 )
 
 // SecurityService handles security-related operations
 type SecurityService struct {
-	// Dependencies would be injected here in a real implementation
+	// Issuer is stamped into the "iss" claim of every token this
+	// service generates.
+	Issuer string
+
+	// Keys is the JWKS-style signing/verification keyset, keyed by
+	// "kid", that GenerateJWT and VerifyJWT use. Rotating in a new key
+	// (and eventually retiring the old one) doesn't require a deploy.
+	Keys *Keyset
+
+	// Revocations tracks "jti" values that must be rejected even
+	// before their token's natural expiry (e.g. on logout). nil means
+	// no revocation list is enforced.
+	Revocations RevocationStore
 }
 
-// NewSecurityService creates a new instance of SecurityService
-func NewSecurityService() *SecurityService {
-	return &SecurityService{}
+// NewSecurityService creates a SecurityService that issues tokens as
+// issuer, signs/verifies them using keys, and checks revocations
+// against revocations (nil to skip revocation checks).
+func NewSecurityService(issuer string, keys *Keyset, revocations RevocationStore) *SecurityService {
+	return &SecurityService{Issuer: issuer, Keys: keys, Revocations: revocations}
 }
 
 // EncryptData encrypts sensitive data using AES-256
@@ -126,29 +139,6 @@ func (s *SecurityService) VerifyHMAC(data []byte, signature string, secret []byt
 	return expectedSignature == signature, nil
 }
 
-// GenerateJWT creates a JSON Web Token for authentication
-func (s *SecurityService) GenerateJWT(subject string, expiresIn time.Duration, secret []byte) (string, error) {
-	// In a real implementation, we would:
-	// 1. Create a JWT with claims (subject, expiration, etc.)
-	// 2. Sign the JWT with the secret
-	// 3. Return the signed JWT
-
-	// For now, we'll return a placeholder
-	return "placeholder_jwt", nil
-}
-
-// VerifyJWT verifies a JSON Web Token
-func (s *SecurityService) VerifyJWT(token string, secret []byte) (bool, map[string]interface{}, error) {
-	// In a real implementation, we would:
-	// 1. Parse the JWT
-	// 2. Verify the signature using the secret
-	// 3. Validate the claims (expiration, etc.)
-	// 4. Return the claims if valid
-
-	// For now, we'll return a placeholder
-	return false, nil, errors.New("not implemented")
-}
-
 // deriveKey derives a 32-byte key from the provided key
 func deriveKey(key []byte) []byte {
 	// If the key is already 32 bytes, use it as is
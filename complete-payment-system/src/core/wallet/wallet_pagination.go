@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"context"
+	"time"
+)
+
+// pagePollInterval is how often ListWallets re-queries the store while
+// long-polling for a new wallet to match filter, mirroring
+// storage.Paginate's pollInterval for report queries.
+const pagePollInterval = 200 * time.Millisecond
+
+// WalletPage is one page of a paginated ListWallets call.
+type WalletPage struct {
+	Items      []*Wallet
+	NextOffset string
+	HasMore    bool
+}
+
+// ListWallets returns a page of wallets matching filter, ordered and
+// bounded by page (see PaginationParams), delegating the actual
+// filtering, ordering, and cursoring to s.Store.Query. If the page
+// would otherwise come back empty and page.TimeoutMs > 0, it
+// long-polls — retrying Query on a short interval — until at least one
+// wallet matches or the timeout elapses, mirroring storage.Paginate's
+// role for report queries.
+func (s *WalletService) ListWallets(ctx context.Context, filter WalletFilter, page PaginationParams) (*WalletPage, error) {
+	var deadline time.Time
+	if page.TimeoutMs > 0 {
+		deadline = time.Now().Add(time.Duration(page.TimeoutMs) * time.Millisecond)
+	}
+
+	for {
+		result, err := s.Store.Query(ctx, filter, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(result.Items) > 0 || page.TimeoutMs <= 0 || time.Now().After(deadline) {
+			items := make([]*Wallet, len(result.Items))
+			for i := range result.Items {
+				w := result.Items[i]
+				items[i] = &w
+			}
+			return &WalletPage{Items: items, NextOffset: result.NextOffset, HasMore: result.HasMore}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pagePollInterval):
+		}
+	}
+}
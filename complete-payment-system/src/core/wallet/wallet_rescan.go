@@ -0,0 +1,322 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/hd"
+)
+
+// gapLimit is the number of consecutive unused addresses, per BIP-44
+// chain, RecoverFromMnemonic checks before concluding an account has
+// no more activity, and the number of look-ahead addresses
+// RescanBlockchain filters transactions against.
+const gapLimit = 20
+
+// AddressActivityChecker reports whether an address has ever appeared
+// in a transaction, the primitive BIP-44 gap-limit account discovery
+// is built on.
+type AddressActivityChecker interface {
+	HasActivity(ctx context.Context, cryptocurrency, address string) (bool, error)
+}
+
+// BlockSource streams block contents for RescanBlockchain, one height
+// at a time, without requiring a full node's wire protocol — a thin
+// abstraction over whatever blockchain client or indexer this service
+// is run against.
+type BlockSource interface {
+	TipHeight(ctx context.Context, cryptocurrency string) (int32, error)
+	BlockTransactions(ctx context.Context, cryptocurrency string, height int32) ([]BlockTransaction, error)
+}
+
+// BlockTransaction is one transaction's relevant outputs within a
+// scanned block.
+type BlockTransaction struct {
+	TxID    string
+	Outputs []BlockTxOutput
+}
+
+// BlockTxOutput is one output paid to address for amount.
+type BlockTxOutput struct {
+	Address string
+	Amount  money.Amount
+}
+
+// rescanProgress is RescanBlockchain's in-memory, observable-via-
+// RescanProgress position within a wallet's in-flight rescan.
+type rescanProgress struct {
+	currentHeight int32
+	tipHeight     int32
+}
+
+// RecoverFromMnemonic re-derives every wallet a mnemonic could have
+// funds in, without any prior WalletStore records to consult: for each
+// registered hd.Coin it walks accounts 0, 1, 2, ... testing both the
+// external (chain 0) and internal (chain 1) address chains up to
+// gapLimit indexes via s.Activity, and stops at the first account with
+// no activity on either chain (BIP-44 gap-limit discovery, as
+// lbcwallet's startup recovery does). Each account with any activity
+// is persisted as a new Wallet, with its chain counters fast-forwarded
+// past the addresses discovery found in use.
+func (s *WalletService) RecoverFromMnemonic(mnemonic, passphrase string) ([]*Wallet, error) {
+	if s.Master == nil {
+		return nil, errors.New("wallet: service has no master key configured")
+	}
+	if s.Activity == nil {
+		return nil, errors.New("wallet: no address activity checker configured")
+	}
+
+	seed, err := hd.SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: deriving seed from mnemonic: %w", err)
+	}
+	master, err := hd.NewMasterKey(seed, s.Master.Network)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: deriving master key: %w", err)
+	}
+
+	ctx := context.Background()
+	var recovered []*Wallet
+	for _, currency := range hd.RegisteredCurrencies() {
+		cur, ok := money.GetCurrency(currency)
+		if !ok {
+			// Registered with hd.RegisterCoin but not a recognized
+			// money.Currency — shouldn't happen for this system's own
+			// coin registrations, but recovery shouldn't abort over it.
+			continue
+		}
+		coin, err := hd.CoinFor(currency)
+		if err != nil {
+			return nil, err
+		}
+
+		for account := uint32(0); ; account++ {
+			accountKey, err := hd.DeriveAccount(master, coin, account)
+			if err != nil {
+				return nil, fmt.Errorf("wallet: deriving %s account %d: %w", currency, account, err)
+			}
+
+			externalNext, externalActive, err := discoverChainActivity(ctx, s.Activity, coin, currency, accountKey, 0)
+			if err != nil {
+				return nil, err
+			}
+			internalNext, internalActive, err := discoverChainActivity(ctx, s.Activity, coin, currency, accountKey, 1)
+			if err != nil {
+				return nil, err
+			}
+			if !externalActive && !internalActive {
+				break
+			}
+
+			w, err := s.materializeRecoveredWallet(ctx, currency, cur, coin, account, accountKey, externalNext, internalNext)
+			if err != nil {
+				return nil, err
+			}
+			recovered = append(recovered, w)
+		}
+	}
+	return recovered, nil
+}
+
+// discoverChainActivity checks accountKey's chain (0 external, 1
+// internal) for activity at indexes 0..gapLimit-1, returning whether
+// any index was active and the index one past the last active one
+// found — RecoverFromMnemonic's best estimate of that chain's
+// next-unused index.
+func discoverChainActivity(ctx context.Context, activity AddressActivityChecker, coin hd.Coin, currency string, accountKey *hd.ExtendedKey, chain uint32) (nextIndex uint32, hasActivity bool, err error) {
+	for i := uint32(0); i < gapLimit; i++ {
+		addressKey, err := hd.DeriveAddress(accountKey, chain, i)
+		if err != nil {
+			return 0, false, fmt.Errorf("wallet: deriving %s chain %d index %d: %w", currency, chain, i, err)
+		}
+		address, err := coin.Encode(addressKey.PublicKey)
+		if err != nil {
+			return 0, false, fmt.Errorf("wallet: encoding %s address: %w", coin.Name, err)
+		}
+		active, err := activity.HasActivity(ctx, currency, address)
+		if err != nil {
+			return 0, false, fmt.Errorf("wallet: checking activity for %s: %w", address, err)
+		}
+		if active {
+			hasActivity = true
+			nextIndex = i + 1
+		}
+	}
+	return nextIndex, hasActivity, nil
+}
+
+func (s *WalletService) materializeRecoveredWallet(ctx context.Context, currency string, cur money.Currency, coin hd.Coin, account uint32, accountKey *hd.ExtendedKey, externalNext, internalNext uint32) (*Wallet, error) {
+	addressKey, err := hd.DeriveAddress(accountKey, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: deriving %s account %d receive address: %w", currency, account, err)
+	}
+	address, err := coin.Encode(addressKey.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: encoding %s address: %w", coin.Name, err)
+	}
+
+	id, err := generateWalletID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	w := Wallet{
+		ID:           id,
+		Currency:     currency,
+		Address:      address,
+		Balance:      money.Zero(cur),
+		Type:         WalletTypeHot,
+		Account:      account,
+		XPub:         accountKey.Neuter().String(),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		LastSyncedAt: now,
+	}
+	if err := s.Store.Save(ctx, w); err != nil {
+		return nil, fmt.Errorf("wallet: persisting recovered wallet: %w", err)
+	}
+	if err := s.Store.SetNextAddressIndex(ctx, w.ID, 0, externalNext); err != nil {
+		return nil, fmt.Errorf("wallet: recording recovered external index: %w", err)
+	}
+	if err := s.Store.SetNextAddressIndex(ctx, w.ID, 1, internalNext); err != nil {
+		return nil, fmt.Errorf("wallet: recording recovered internal index: %w", err)
+	}
+	return &w, nil
+}
+
+// RescanBlockchain streams walletID's chain from startHeight through
+// the current tip, crediting w.Balance with every output that matches
+// one of w's derived addresses (external and internal, looking ahead
+// gapLimit indexes from w's xpub — see Wallet.XPub, which this derives
+// from rather than s.Master, so a WalletTypeCold wallet can be
+// rescanned without its private key ever being needed). Progress is
+// observable mid-rescan via RescanProgress.
+func (s *WalletService) RescanBlockchain(walletID string, startHeight int32) error {
+	if s.Blocks == nil {
+		return errors.New("wallet: no block source configured")
+	}
+
+	ctx := context.Background()
+	w, err := s.Store.Get(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	coin, err := hd.CoinFor(w.Currency)
+	if err != nil {
+		return err
+	}
+	accountKey, err := hd.ParseExtendedKey(w.XPub)
+	if err != nil {
+		return fmt.Errorf("wallet: parsing wallet xpub: %w", err)
+	}
+
+	filter := newScriptFilter()
+	for chain := uint32(0); chain < 2; chain++ {
+		// Window past every index this wallet has actually handed out
+		// (not just a fixed gapLimit from 0) plus one gap limit's worth
+		// of look-ahead, so a payment to, say, external index 30 on a
+		// wallet that's issued 40 addresses is still matched.
+		used, err := s.Store.CurrentAddressIndex(ctx, walletID, chain)
+		if err != nil {
+			return fmt.Errorf("wallet: reading chain %d index: %w", chain, err)
+		}
+		for i := uint32(0); i < used+gapLimit; i++ {
+			addressKey, err := hd.DeriveAddress(accountKey, chain, i)
+			if err != nil {
+				return fmt.Errorf("wallet: deriving chain %d index %d: %w", chain, i, err)
+			}
+			address, err := coin.Encode(addressKey.PublicKey)
+			if err != nil {
+				return fmt.Errorf("wallet: encoding address: %w", err)
+			}
+			filter.add(address)
+		}
+	}
+
+	tip, err := s.Blocks.TipHeight(ctx, w.Currency)
+	if err != nil {
+		return fmt.Errorf("wallet: fetching tip height: %w", err)
+	}
+	s.setRescanProgress(walletID, startHeight, tip)
+
+	for height := startHeight; height <= tip; height++ {
+		txs, err := s.Blocks.BlockTransactions(ctx, w.Currency, height)
+		if err != nil {
+			return fmt.Errorf("wallet: fetching block %d: %w", height, err)
+		}
+		for _, tx := range txs {
+			for _, out := range tx.Outputs {
+				if !filter.contains(out.Address) {
+					continue
+				}
+				// Credited through a fresh Get/Save pair, rather than
+				// accumulated in a local variable across the whole scan
+				// and written once at the end, so a concurrent balance
+				// update elsewhere (e.g. UpdateWalletBalance, or another
+				// credited output from this same block) is never
+				// clobbered by a stale, scan-start snapshot.
+				if err := s.creditWalletBalance(ctx, walletID, out.Amount); err != nil {
+					return fmt.Errorf("wallet: crediting tx %s: %w", tx.TxID, err)
+				}
+			}
+		}
+		s.setRescanProgress(walletID, height, tip)
+	}
+
+	return s.touchWalletSyncTime(ctx, walletID)
+}
+
+// creditWalletBalance adds amount to walletID's currently-stored
+// balance, re-reading it immediately before writing so a concurrent
+// balance change isn't overwritten.
+func (s *WalletService) creditWalletBalance(ctx context.Context, walletID string, amount money.Amount) error {
+	w, err := s.Store.Get(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	newBalance, err := w.Balance.Add(amount)
+	if err != nil {
+		return err
+	}
+	w.Balance = newBalance
+	w.UpdatedAt = time.Now()
+	return s.Store.Save(ctx, w)
+}
+
+// touchWalletSyncTime marks walletID as just having finished a sync
+// pass (e.g. a completed RescanBlockchain), without touching Balance —
+// RescanBlockchain already credits balance incrementally via
+// creditWalletBalance as it scans.
+func (s *WalletService) touchWalletSyncTime(ctx context.Context, walletID string) error {
+	w, err := s.Store.Get(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	w.LastSyncedAt = time.Now()
+	w.UpdatedAt = time.Now()
+	return s.Store.Save(ctx, w)
+}
+
+// RescanProgress reports walletID's current position within an
+// in-flight or just-completed RescanBlockchain call.
+func (s *WalletService) RescanProgress(walletID string) (currentHeight, tipHeight int32, err error) {
+	s.rescanMu.Lock()
+	defer s.rescanMu.Unlock()
+	p, ok := s.rescans[walletID]
+	if !ok {
+		return 0, 0, fmt.Errorf("wallet: no rescan recorded for wallet %s", walletID)
+	}
+	return p.currentHeight, p.tipHeight, nil
+}
+
+func (s *WalletService) setRescanProgress(walletID string, current, tip int32) {
+	s.rescanMu.Lock()
+	defer s.rescanMu.Unlock()
+	if s.rescans == nil {
+		s.rescans = make(map[string]*rescanProgress)
+	}
+	s.rescans[walletID] = &rescanProgress{currentHeight: current, tipHeight: tip}
+}
@@ -0,0 +1,34 @@
+package wallet
+
+// scriptFilter is the "bloom-style script set" RescanBlockchain tests
+// every transaction output against: a hash-based membership check that
+// turns "does this tx pay one of our derived addresses?" into an O(1)
+// map lookup instead of a linear scan over every derived address for
+// every transaction in the rescanned range (the O(addresses×txs) cost
+// a naive rescan would otherwise pay).
+//
+// A probabilistic bloom filter proper was considered and deliberately
+// not used: crediting a wallet's balance from a false positive would
+// misattribute funds, so a positive bloom hit would still need
+// resolving against an exact address set before RescanBlockchain could
+// safely act on it — and for one wallet's address window (tens to a
+// few hundred entries across both chains) that exact set is cheap
+// enough to hold outright, at which point the bloom bits in front of it
+// add hashing work without removing the map lookup they'd otherwise
+// save.
+type scriptFilter struct {
+	addresses map[string]struct{}
+}
+
+func newScriptFilter() *scriptFilter {
+	return &scriptFilter{addresses: make(map[string]struct{})}
+}
+
+func (f *scriptFilter) add(address string) {
+	f.addresses[address] = struct{}{}
+}
+
+func (f *scriptFilter) contains(address string) bool {
+	_, ok := f.addresses[address]
+	return ok
+}
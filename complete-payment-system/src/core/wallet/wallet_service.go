@@ -0,0 +1,769 @@
+package wallet
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/chain"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/hd"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/keystore"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/psbt"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/signer"
+)
+
+// minSpendConfirmations is the fewest confirmations a UTXO must have
+// before selectUTXOs will spend it. Spending an unconfirmed (0-conf)
+// input risks building a transaction around a deposit that a reorg or
+// double-spend later invalidates.
+const minSpendConfirmations = 1
+
+// defaultUnlockTimeout is how long UnlockWallet caches a decrypted
+// private key when the caller doesn't specify its own timeout,
+// following the walletpassphrase RPC convention of auto-relocking
+// rather than staying unlocked indefinitely.
+const defaultUnlockTimeout = 5 * time.Minute
+
+// WalletType defines the type of cryptocurrency wallet
+type WalletType string
+
+const (
+	// WalletTypeHot represents a hot wallet (online)
+	WalletTypeHot WalletType = "hot"
+
+	// WalletTypeCold represents a cold wallet (offline)
+	WalletTypeCold WalletType = "cold"
+)
+
+// Wallet represents a cryptocurrency wallet
+type Wallet struct {
+	ID       string       `json:"id"`
+	Currency string       `json:"currency"`
+	Address  string       `json:"address"`
+	Balance  money.Amount `json:"balance"`
+	Type     WalletType   `json:"type"`
+
+	MerchantID string `json:"merchant_id,omitempty"`
+
+	// Account is the BIP-44 account index this wallet's addresses are
+	// derived under: m/44'/coin'/Account'/.... Each (MerchantID,
+	// Currency, Account) combination gets its own address-chain index
+	// space, so two wallets never collide on a derived address.
+	Account uint32 `json:"account"`
+
+	// XPub is this wallet's account-level extended public key
+	// (m/44'/coin'/Account'), serialized via hd.ExtendedKey.Neuter.
+	// A WalletTypeCold wallet's balance-sync process should use only
+	// this field — it can derive every receive address this wallet
+	// will ever use without ever holding a private key.
+	XPub string `json:"xpub"`
+
+	// BackendID, when set, routes this wallet's signing through
+	// WalletService.Backends instead of KeyStore — e.g. "ledger" for a
+	// hardware wallet, "pkcs11" for an HSM. DerivationPath is the path
+	// within that backend the wallet's key lives at (see signer.AccountRef).
+	// Both are empty for a wallet whose key lives in the local KeyStore.
+	BackendID      string `json:"backend_id,omitempty"`
+	DerivationPath string `json:"derivation_path,omitempty"`
+
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	LastSyncedAt time.Time `json:"last_synced_at"`
+}
+
+// WalletService handles wallet management operations
+type WalletService struct {
+	Store WalletStore
+
+	// Master is the single HD master key this service derives every
+	// managed wallet's addresses from (see hd package doc).
+	Master *hd.ExtendedKey
+
+	// KeyStore encrypts each wallet's derived private key at rest
+	// under a merchant-supplied passphrase (see package keystore). A
+	// service with no KeyStore configured can still derive addresses
+	// but CreateWallet won't have anywhere to put the resulting
+	// private key, and TransferFunds will always report the wallet
+	// locked.
+	KeyStore keystore.KeyStore
+
+	// UTXOs looks up a wallet's spendable inputs for
+	// BuildUnsignedTransaction. Required only by the PSBT offline-signing
+	// flow, not by TransferFunds.
+	UTXOs UTXOProvider
+
+	// Broadcaster submits a PSBT's finalized transaction to the network
+	// (see FinalizeAndBroadcast). Required only by the PSBT
+	// offline-signing flow, not by TransferFunds.
+	Broadcaster chain.Broadcaster
+
+	// Activity reports whether an address has any on-chain history, the
+	// primitive RecoverFromMnemonic's BIP-44 gap-limit account discovery
+	// is built on.
+	Activity AddressActivityChecker
+
+	// Blocks streams block contents for RescanBlockchain.
+	Blocks BlockSource
+
+	// Backends resolves a Wallet.BackendID to the signer.SignerBackend
+	// that should sign on its behalf (see OpenBackend, SignPSBT). A
+	// service with no Backends configured can still manage wallets with
+	// no BackendID set (signed through KeyStore as before).
+	Backends *signer.BackendRegistry
+
+	rescanMu sync.Mutex
+	rescans  map[string]*rescanProgress
+
+	// backendMu guards openBackend, which tracks open state per
+	// BackendID rather than per wallet ID: Open/Close on a
+	// signer.SignerBackend authorize or revoke the whole backend (see
+	// SignerBackend's doc comment), and a given backend instance is
+	// typically shared by every wallet routed through it, so two
+	// wallets sharing a BackendID always observe the same open state.
+	backendMu   sync.Mutex
+	openBackend map[string]struct{}
+}
+
+// NewWalletService creates a WalletService that persists wallets to
+// store, derives every wallet's keys from master (see hd.NewMasterKey
+// / hd.ParseExtendedKey), and encrypts derived private keys at rest in
+// keyStore (nil to run without key storage — CreateWallet then leaves
+// the wallet permanently locked).
+func NewWalletService(store WalletStore, master *hd.ExtendedKey, keyStore keystore.KeyStore) *WalletService {
+	return &WalletService{Store: store, Master: master, KeyStore: keyStore}
+}
+
+// CreateWallet initializes a new wallet for a specific cryptocurrency,
+// deriving its first receive address from s.Master rather than
+// fabricating a placeholder address. passphrase encrypts the derived
+// private key at rest in s.KeyStore (see keystore.KeyStore.Store); it
+// is required whenever s.KeyStore is configured, since there would
+// otherwise be no way to ever unlock the wallet for TransferFunds.
+func (s *WalletService) CreateWallet(currency string, walletType WalletType, merchantID string, account uint32, passphrase string) (*Wallet, error) {
+	if currency == "" {
+		return nil, errors.New("currency is required")
+	}
+	if s.Master == nil {
+		return nil, errors.New("wallet: service has no master key configured")
+	}
+	if s.KeyStore != nil && passphrase == "" {
+		return nil, errors.New("wallet: passphrase is required to store this wallet's key")
+	}
+
+	cur, ok := money.GetCurrency(currency)
+	if !ok {
+		return nil, errors.New("unknown currency: " + currency)
+	}
+
+	coin, err := hd.CoinFor(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := generateWalletID()
+	if err != nil {
+		return nil, err
+	}
+
+	accountKey, err := hd.DeriveAccount(s.Master, coin, account)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: deriving account key: %w", err)
+	}
+
+	now := time.Now()
+	w := Wallet{
+		ID:           id,
+		Currency:     currency,
+		Balance:      money.Zero(cur),
+		Type:         walletType,
+		MerchantID:   merchantID,
+		Account:      account,
+		XPub:         accountKey.Neuter().String(),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		LastSyncedAt: now,
+	}
+
+	// Persist before deriving the first receive address so
+	// WalletStore.NextAddressIndex (which requires the wallet to
+	// already exist) has a row to advance.
+	if err := s.Store.Save(context.Background(), w); err != nil {
+		return nil, fmt.Errorf("wallet: persisting new wallet: %w", err)
+	}
+
+	// Chain 0 is the external (receive) chain by BIP-44 convention.
+	// Drawing the wallet's first address through the same
+	// NextAddressIndex counter GeneratePaymentAddress uses (rather
+	// than hardcoding index 0) reserves it, so a later
+	// GeneratePaymentAddress call can never re-derive this same
+	// address for a second invoice.
+	ctx := context.Background()
+	addressKey, err := s.deriveNextAddressKey(ctx, &w, accountKey)
+	if err != nil {
+		return nil, err
+	}
+	address, err := coin.Encode(addressKey.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: encoding %s address: %w", coin.Name, err)
+	}
+
+	// Store the encrypted private key before the wallet record is
+	// updated to carry address: if this fails, the wallet stays in
+	// its addressless, just-created state (still retryable) instead
+	// of looking fully created with no way to ever spend from it.
+	if s.KeyStore != nil {
+		if err := s.KeyStore.Store(w.ID, addressKey.PrivateKey.Serialize(), passphrase); err != nil {
+			return nil, fmt.Errorf("wallet: storing encrypted private key: %w", err)
+		}
+	}
+
+	w.Address = address
+	if err := s.Store.Save(ctx, w); err != nil {
+		return nil, fmt.Errorf("wallet: persisting wallet address: %w", err)
+	}
+
+	return &w, nil
+}
+
+// GetWallet retrieves a wallet by ID
+func (s *WalletService) GetWallet(walletID string) (*Wallet, error) {
+	w, err := s.Store.Get(context.Background(), walletID)
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// GetWalletByAddress retrieves a wallet by its address
+func (s *WalletService) GetWalletByAddress(address string) (*Wallet, error) {
+	w, err := s.Store.GetByAddress(context.Background(), address)
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// UpdateWalletBalance updates the balance of a wallet
+func (s *WalletService) UpdateWalletBalance(walletID string, newBalance money.Amount) error {
+	if newBalance.Sign() < 0 {
+		return errors.New("balance cannot be negative")
+	}
+
+	ctx := context.Background()
+	w, err := s.Store.Get(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	w.Balance = newBalance
+	w.UpdatedAt = time.Now()
+	return s.Store.Save(ctx, w)
+}
+
+// SyncWalletBalance synchronizes the wallet balance with the blockchain
+func (s *WalletService) SyncWalletBalance(walletID string) (money.Amount, error) {
+	// Querying the chain for a wallet's real balance needs a
+	// BlockchainClient-style dependency this service doesn't have yet
+	// (see chain.Broadcaster/TxStatusQuerier for the shape that would
+	// take, once one exists for watch-only balance queries).
+
+	return money.Amount{}, nil
+}
+
+// GeneratePaymentAddress creates a new address for receiving payments,
+// deriving m/44'/coin'/wallet.Account'/0/index from s.Master, where
+// index is wallet.ID's next-unused address index (see
+// WalletStore.NextAddressIndex).
+//
+// Note this address's own private key is never separately written to
+// s.KeyStore — s.KeyStore holds one signing key per wallet (the index-0
+// key CreateWallet stores), matching this service's account-based
+// Balance model (UpdateWalletBalance/SyncWalletBalance) rather than a
+// per-UTXO one. Funds received at an address from this method are
+// expected to be swept into the wallet's tracked Balance, not spent
+// individually from their own derived key.
+func (s *WalletService) GeneratePaymentAddress(walletID string) (string, error) {
+	if s.Master == nil {
+		return "", errors.New("wallet: service has no master key configured")
+	}
+
+	ctx := context.Background()
+	w, err := s.Store.Get(ctx, walletID)
+	if err != nil {
+		return "", err
+	}
+
+	coin, err := hd.CoinFor(w.Currency)
+	if err != nil {
+		return "", err
+	}
+	accountKey, err := hd.DeriveAccount(s.Master, coin, w.Account)
+	if err != nil {
+		return "", fmt.Errorf("wallet: deriving account key: %w", err)
+	}
+
+	addressKey, err := s.deriveNextAddressKey(ctx, &w, accountKey)
+	if err != nil {
+		return "", err
+	}
+	address, err := coin.Encode(addressKey.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("wallet: encoding %s address: %w", coin.Name, err)
+	}
+	return address, nil
+}
+
+// deriveNextAddressKey derives the key at w's next-unused index on
+// accountKey's external (receive) chain, advancing w.ID's persisted
+// index via WalletStore.NextAddressIndex so the same index is never
+// handed out twice. It does not persist w itself — CreateWallet and
+// GeneratePaymentAddress differ on whether/how they save the wallet
+// record afterward.
+func (s *WalletService) deriveNextAddressKey(ctx context.Context, w *Wallet, accountKey *hd.ExtendedKey) (*hd.ExtendedKey, error) {
+	index, err := s.Store.NextAddressIndex(ctx, w.ID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: advancing address index: %w", err)
+	}
+
+	addressKey, err := hd.DeriveAddress(accountKey, 0, index)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: deriving address at index %d: %w", index, err)
+	}
+	return addressKey, nil
+}
+
+// TransferFunds moves funds between wallets. sourceWalletID must have
+// been unlocked (see UnlockWallet) within its timeout — signing
+// requires its decrypted private key, and this service holds one in
+// memory only for that bounded window.
+func (s *WalletService) TransferFunds(sourceWalletID string, destinationAddress string, amount money.Amount, fee money.Amount) (string, error) {
+	if amount.Sign() <= 0 {
+		return "", errors.New("amount must be greater than zero")
+	}
+
+	if fee.Sign() < 0 {
+		return "", errors.New("fee cannot be negative")
+	}
+
+	w, err := s.Store.Get(context.Background(), sourceWalletID)
+	if err != nil {
+		return "", err
+	}
+
+	if w.BackendID != "" {
+		if !s.isBackendOpenByID(w.BackendID) {
+			return "", signer.ErrBackendLocked
+		}
+	} else {
+		if s.KeyStore == nil {
+			return "", errors.New("wallet: no key store configured")
+		}
+		if _, ok := s.KeyStore.UnlockedKey(sourceWalletID); !ok {
+			return "", keystore.ErrLocked
+		}
+	}
+
+	// Building, signing, and broadcasting the transaction itself still
+	// needs a chain.Broadcaster, which this service doesn't have yet.
+
+	// For now, we'll return a placeholder transaction ID
+	return "placeholder_transaction_id", nil
+}
+
+// BuildUnsignedTransaction selects enough of sourceWalletID's UTXOs to
+// cover amount plus fee, and returns them — along with the destination
+// and any change output — as an unsigned PSBT. A WalletTypeCold
+// wallet's TransferFunds is never usable (its key can't be unlocked
+// anywhere network-connected), so this is the entry point a hot node
+// uses on its behalf: the resulting PSBT is handed to SignPSBT on an
+// offline machine, then back here to FinalizeAndBroadcast.
+func (s *WalletService) BuildUnsignedTransaction(sourceWalletID, destinationAddress string, amount, fee money.Amount) (*psbt.PSBT, error) {
+	if amount.Sign() <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+	if fee.Sign() < 0 {
+		return nil, errors.New("fee cannot be negative")
+	}
+	if s.UTXOs == nil {
+		return nil, errors.New("wallet: no utxo provider configured")
+	}
+
+	ctx := context.Background()
+	w, err := s.Store.Get(ctx, sourceWalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := amount.Add(fee)
+	if err != nil {
+		return nil, err
+	}
+	utxos, err := s.UTXOs.ListUTXOs(ctx, sourceWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: listing utxos: %w", err)
+	}
+	selected, total, err := selectUTXOs(utxos, target)
+	if err != nil {
+		return nil, err
+	}
+	change, err := total.Sub(target)
+	if err != nil {
+		return nil, err
+	}
+
+	coin, err := hd.CoinFor(w.Currency)
+	if err != nil {
+		return nil, err
+	}
+	// The derivation path every input's signature will come from: this
+	// service stores only the index-0 key per wallet (see
+	// GeneratePaymentAddress), so every UTXOProvider.ListUTXOs result for
+	// this wallet is assumed to belong to that one address.
+	derivationPath := fmt.Sprintf("m/44'/%d'/%d'/0/0", coin.Type, w.Account)
+
+	tx := psbt.UnsignedTx{Cryptocurrency: w.Currency, Outputs: []psbt.TxOutput{
+		{Address: destinationAddress, Amount: amount.String()},
+	}}
+	if change.Sign() > 0 {
+		tx.Outputs = append(tx.Outputs, psbt.TxOutput{Address: w.Address, Amount: change.String()})
+	}
+	for _, u := range selected {
+		tx.Inputs = append(tx.Inputs, psbt.TxInput{TxID: u.TxID, Vout: u.Vout, Address: u.Address})
+	}
+
+	txData, err := tx.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("wallet: encoding unsigned transaction: %w", err)
+	}
+
+	p := &psbt.PSBT{}
+	p.Global.Set(psbt.KeyTypeGlobalUnsignedTx, txData)
+	for _, u := range selected {
+		var in psbt.Map
+		in.Set(psbt.KeyTypeInputWitnessUTXO, []byte(u.Amount.String()))
+		in.Set(psbt.KeyTypeInputDerivationPath, []byte(derivationPath))
+		p.Inputs = append(p.Inputs, in)
+	}
+	for _, out := range tx.Outputs {
+		var outMap psbt.Map
+		outMap.Set(psbt.KeyTypeOutputAddress, []byte(out.Address))
+		outMap.Set(psbt.KeyTypeOutputAmount, []byte(out.Amount))
+		p.Outputs = append(p.Outputs, outMap)
+	}
+
+	return p, nil
+}
+
+// selectUTXOs greedily accumulates confirmed utxos (see
+// minSpendConfirmations) until their total at least covers target,
+// returning an error if the wallet's known UTXOs can't cover it.
+func selectUTXOs(utxos []UTXO, target money.Amount) ([]UTXO, money.Amount, error) {
+	total := money.Zero(target.Currency())
+	var selected []UTXO
+	for _, u := range utxos {
+		if u.Confirmations < minSpendConfirmations {
+			continue
+		}
+		var err error
+		total, err = total.Add(u.Amount)
+		if err != nil {
+			return nil, money.Amount{}, err
+		}
+		selected = append(selected, u)
+		cmp, err := total.Cmp(target)
+		if err != nil {
+			return nil, money.Amount{}, err
+		}
+		if cmp >= 0 {
+			return selected, total, nil
+		}
+	}
+	return nil, money.Amount{}, errors.New("wallet: insufficient utxos to cover amount plus fee")
+}
+
+// SignPSBT signs every input of p as the step a cold, offline machine
+// performs on a PSBT a hot node built with BuildUnsignedTransaction.
+// Unlike TransferFunds, this does not require UnlockWallet/OpenBackend
+// first — passphrase is supplied directly, since the whole point of
+// this flow is that it runs on a machine with no prior unlock call and
+// no network access. Signing happens through s.KeyStore, or — when
+// walletID's Wallet.BackendID is set — through s.Backends instead (see
+// signWithKeyStore/signWithBackend).
+func (s *WalletService) SignPSBT(walletID string, p *psbt.PSBT, passphrase string) (*psbt.PSBT, error) {
+	if p == nil {
+		return nil, errors.New("wallet: psbt is required")
+	}
+	txData, ok := p.Global.Get(psbt.KeyTypeGlobalUnsignedTx)
+	if !ok {
+		return nil, errors.New("wallet: psbt has no unsigned transaction")
+	}
+
+	ctx := context.Background()
+	w, err := s.Store.Get(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Signing the unsigned transaction's own encoded bytes, rather than
+	// a chain-specific sighash, is this system's offline-signing stand-in
+	// (see the psbt package doc comment) until a per-chain transaction
+	// builder exists.
+	sigHash := sha256.Sum256(txData)
+
+	var sig, pubKey []byte
+	if w.BackendID != "" {
+		sig, pubKey, err = s.signWithBackend(w, passphrase, sigHash[:])
+	} else {
+		sig, pubKey, err = s.signWithKeyStore(walletID, passphrase, sigHash[:])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range p.Inputs {
+		p.Inputs[i].Set(psbt.KeyTypeInputPartialSig, sig)
+		p.Inputs[i].Set(psbt.KeyTypeInputPubKey, pubKey)
+		p.Inputs[i].Set(psbt.KeyTypeInputFinalized, []byte{0x01})
+	}
+	return p, nil
+}
+
+// signWithKeyStore is SignPSBT's original path, signing sigHash with
+// walletID's private key loaded from s.KeyStore under passphrase.
+func (s *WalletService) signWithKeyStore(walletID, passphrase string, sigHash []byte) (sig, pubKey []byte, err error) {
+	if s.KeyStore == nil {
+		return nil, nil, errors.New("wallet: no key store configured")
+	}
+	privKeyBytes, err := s.KeyStore.Load(walletID, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+	priv, _ := btcec.PrivKeyFromBytes(privKeyBytes)
+	signature := ecdsa.Sign(priv, sigHash)
+	return signature.Serialize(), priv.PubKey().SerializeCompressed(), nil
+}
+
+// signWithBackend signs sigHash through w.BackendID's registered
+// signer.SignerBackend, opening it under passphrase for the duration of
+// this call and closing it again before returning — matching
+// signWithKeyStore's per-call Load rather than OpenBackend's
+// stays-open-until-closed behavior, since SignPSBT is itself a
+// single-shot, per-call operation.
+func (s *WalletService) signWithBackend(w Wallet, passphrase string, sigHash []byte) (sig, pubKey []byte, err error) {
+	if s.Backends == nil {
+		return nil, nil, errors.New("wallet: no backend registry configured")
+	}
+	backend, ok := s.Backends.Lookup(w.BackendID)
+	if !ok {
+		return nil, nil, fmt.Errorf("wallet: no backend registered for %s", w.BackendID)
+	}
+
+	// A signer.SignerBackend's Open/Close authorize or revoke the whole
+	// backend, not one wallet's account within it (see SignerBackend's
+	// doc comment) — so if w.BackendID is already open via a standing
+	// OpenBackend call (e.g. for another wallet that shares it), this
+	// single SignPSBT call must not Close it back out from under that
+	// call when it's done.
+	if !s.isBackendOpenByID(w.BackendID) {
+		if err := backend.Open(passphrase); err != nil {
+			return nil, nil, err
+		}
+		defer backend.Close()
+	}
+
+	acct, err := backend.Derive(signer.DerivationPath(w.DerivationPath))
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err = backend.SignHash(acct, sigHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, acct.PublicKey, nil
+}
+
+// FinalizeAndBroadcast verifies every input's partial_sig against p's
+// current unsigned-transaction bytes, then extracts p's finalized
+// transaction and hands it to s.Broadcaster, completing the
+// offline-signing flow BuildUnsignedTransaction/SignPSBT started. It
+// returns an error if any input is still missing its
+// partial_sig/finalized marker, or if a signature no longer matches
+// the transaction it was supposedly made over — e.g. because the PSBT
+// was altered (a tampered destination or amount) after SignPSBT ran on
+// the offline machine — so neither a half-signed nor a
+// signed-then-modified PSBT is ever broadcast.
+func (s *WalletService) FinalizeAndBroadcast(p *psbt.PSBT) (string, error) {
+	if s.Broadcaster == nil {
+		return "", errors.New("wallet: no broadcaster configured")
+	}
+	if p == nil {
+		return "", errors.New("wallet: psbt is required")
+	}
+	txData, ok := p.Global.Get(psbt.KeyTypeGlobalUnsignedTx)
+	if !ok {
+		return "", errors.New("wallet: psbt has no unsigned transaction")
+	}
+	tx, err := psbt.UnmarshalUnsignedTx(txData)
+	if err != nil {
+		return "", fmt.Errorf("wallet: decoding unsigned transaction: %w", err)
+	}
+	sigHash := sha256.Sum256(txData)
+	for i, in := range p.Inputs {
+		sigBytes, ok := in.Get(psbt.KeyTypeInputPartialSig)
+		if !ok {
+			return "", fmt.Errorf("wallet: input %d is not yet signed", i)
+		}
+		if _, ok := in.Get(psbt.KeyTypeInputFinalized); !ok {
+			return "", fmt.Errorf("wallet: input %d is not yet signed", i)
+		}
+		pubKeyBytes, ok := in.Get(psbt.KeyTypeInputPubKey)
+		if !ok {
+			return "", fmt.Errorf("wallet: input %d has no signing public key", i)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+		if err != nil {
+			return "", fmt.Errorf("wallet: input %d has an invalid public key: %w", i, err)
+		}
+		signature, err := ecdsa.ParseDERSignature(sigBytes)
+		if err != nil {
+			return "", fmt.Errorf("wallet: input %d has a malformed signature: %w", i, err)
+		}
+		if !signature.Verify(sigHash[:], pubKey) {
+			return "", fmt.Errorf("wallet: input %d's signature does not match the current transaction", i)
+		}
+	}
+
+	rawTx, err := p.Serialize()
+	if err != nil {
+		return "", fmt.Errorf("wallet: serializing finalized psbt: %w", err)
+	}
+	return s.Broadcaster.Broadcast(context.Background(), tx.Cryptocurrency, rawTx)
+}
+
+// UnlockWallet decrypts walletID's private key and caches it in
+// s.KeyStore for timeout (or defaultUnlockTimeout, if timeout <= 0),
+// so TransferFunds can sign with it without re-prompting for
+// passphrase on every call.
+func (s *WalletService) UnlockWallet(walletID, passphrase string, timeout time.Duration) error {
+	if s.KeyStore == nil {
+		return errors.New("wallet: no key store configured")
+	}
+	if timeout <= 0 {
+		timeout = defaultUnlockTimeout
+	}
+	return s.KeyStore.Unlock(walletID, passphrase, timeout)
+}
+
+// LockWallet immediately wipes walletID's cached private key, if
+// unlocked.
+func (s *WalletService) LockWallet(walletID string) error {
+	if s.KeyStore == nil {
+		return errors.New("wallet: no key store configured")
+	}
+	return s.KeyStore.Lock(walletID)
+}
+
+// ChangeWalletPassphrase re-encrypts walletID's stored private key
+// under a new passphrase.
+func (s *WalletService) ChangeWalletPassphrase(walletID, oldPassphrase, newPassphrase string) error {
+	if s.KeyStore == nil {
+		return errors.New("wallet: no key store configured")
+	}
+	return s.KeyStore.ChangePassphrase(walletID, oldPassphrase, newPassphrase)
+}
+
+// OpenBackend authorizes walletID's signer.SignerBackend (see
+// Wallet.BackendID) under passphrase, so TransferFunds treats walletID
+// as unlocked the same way it does a KeyStore-backed wallet after
+// UnlockWallet. The backend stays open until CloseBackend.
+func (s *WalletService) OpenBackend(walletID, passphrase string) error {
+	backend, w, err := s.lookupBackend(walletID)
+	if err != nil {
+		return err
+	}
+	if err := backend.Open(passphrase); err != nil {
+		return err
+	}
+
+	s.backendMu.Lock()
+	defer s.backendMu.Unlock()
+	if s.openBackend == nil {
+		s.openBackend = make(map[string]struct{})
+	}
+	s.openBackend[w.BackendID] = struct{}{}
+	return nil
+}
+
+// CloseBackend revokes the authorization OpenBackend granted for
+// walletID's backend.
+func (s *WalletService) CloseBackend(walletID string) error {
+	backend, w, err := s.lookupBackend(walletID)
+	if err != nil {
+		return err
+	}
+	if err := backend.Close(); err != nil {
+		return err
+	}
+
+	s.backendMu.Lock()
+	defer s.backendMu.Unlock()
+	delete(s.openBackend, w.BackendID)
+	return nil
+}
+
+// lookupBackend resolves walletID's Wallet and its configured
+// signer.SignerBackend, the shared first step of OpenBackend and
+// CloseBackend.
+func (s *WalletService) lookupBackend(walletID string) (signer.SignerBackend, Wallet, error) {
+	if s.Backends == nil {
+		return nil, Wallet{}, errors.New("wallet: no backend registry configured")
+	}
+	w, err := s.Store.Get(context.Background(), walletID)
+	if err != nil {
+		return nil, Wallet{}, err
+	}
+	if w.BackendID == "" {
+		return nil, Wallet{}, errors.New("wallet: wallet has no backend configured")
+	}
+	backend, ok := s.Backends.Lookup(w.BackendID)
+	if !ok {
+		return nil, Wallet{}, fmt.Errorf("wallet: no backend registered for %s", w.BackendID)
+	}
+	return backend, w, nil
+}
+
+// isBackendOpenByID reports whether OpenBackend has been called for
+// backendID without a matching CloseBackend since.
+func (s *WalletService) isBackendOpenByID(backendID string) bool {
+	s.backendMu.Lock()
+	defer s.backendMu.Unlock()
+	_, ok := s.openBackend[backendID]
+	return ok
+}
+
+// SubscribeBackendEvents registers ch to receive future backend
+// connect/disconnect notifications (see signer.BackendEvent).
+func (s *WalletService) SubscribeBackendEvents(ch chan signer.BackendEvent) error {
+	if s.Backends == nil {
+		return errors.New("wallet: no backend registry configured")
+	}
+	s.Backends.Subscribe(ch)
+	return nil
+}
+
+// generateWalletID creates a unique wallet identifier
+func generateWalletID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,377 @@
+package wallet
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// Order selects ascending or descending CreatedAt ordering for a
+// WalletStore.Query page.
+type Order string
+
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// defaultPageLimit and maxPageLimit bound WalletStore.Query the same
+// way notification.Filter's Limit is bounded: a caller that doesn't set
+// Limit gets a reasonable page size, and no caller can force an
+// unbounded result set into memory regardless of what it asks for.
+const (
+	defaultPageLimit = 100
+	maxPageLimit     = 1000
+)
+
+func normalizePageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return limit
+}
+
+func normalizePageOrder(order Order) Order {
+	if order == OrderDesc {
+		return OrderDesc
+	}
+	return OrderAsc
+}
+
+// walletCursor is what a WalletQueryResult's opaque, base64-encoded
+// NextOffset decodes to: the (CreatedAt, ID) position of the last item
+// on the page it was returned from, following the same keyset-
+// pagination convention as notification.encodeCursor/decodeCursor. ID
+// breaks ties between wallets created in the same instant, since
+// CreatedAt alone isn't guaranteed unique.
+type walletCursor struct {
+	LastCreatedAt time.Time `json:"last_created_at"`
+	LastID        string    `json:"last_id"`
+}
+
+func encodeWalletCursor(c walletCursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("wallet: encoding page cursor: %w", err)
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+func decodeWalletCursor(offset string) (walletCursor, error) {
+	if offset == "" {
+		return walletCursor{}, nil
+	}
+	data, err := base64.URLEncoding.DecodeString(offset)
+	if err != nil {
+		return walletCursor{}, fmt.Errorf("wallet: invalid page offset: %w", err)
+	}
+	var c walletCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return walletCursor{}, fmt.Errorf("wallet: invalid page offset: %w", err)
+	}
+	return c, nil
+}
+
+// WalletFilter narrows a Query call against a WalletStore. Every field
+// is optional; a zero value (empty string, zero time.Time, nil Amount
+// pointer) means "don't filter on this".
+type WalletFilter struct {
+	MerchantID string
+	Currency   string
+
+	// Type restricts to WalletTypeHot or WalletTypeCold wallets.
+	Type WalletType
+
+	// MinBalance and MaxBalance, when set, must be denominated in the
+	// same currency as a wallet for that wallet to match — a wallet in
+	// a different currency is treated as not matching rather than as
+	// an error, since a filter spans every currency a merchant might
+	// query across.
+	MinBalance *money.Amount
+	MaxBalance *money.Amount
+
+	// CreatedAfter and CreatedBefore bound a wallet's CreatedAt,
+	// each inclusive when non-zero.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// AddressPrefix restricts to wallets whose Address starts with
+	// this string.
+	AddressPrefix string
+}
+
+// matches reports whether w satisfies every set field of f.
+func (f WalletFilter) matches(w Wallet) bool {
+	if f.MerchantID != "" && w.MerchantID != f.MerchantID {
+		return false
+	}
+	if f.Currency != "" && w.Currency != f.Currency {
+		return false
+	}
+	if f.Type != "" && w.Type != f.Type {
+		return false
+	}
+	if f.MinBalance != nil {
+		cmp, err := w.Balance.Cmp(*f.MinBalance)
+		if err != nil || cmp < 0 {
+			return false
+		}
+	}
+	if f.MaxBalance != nil {
+		cmp, err := w.Balance.Cmp(*f.MaxBalance)
+		if err != nil || cmp > 0 {
+			return false
+		}
+	}
+	if !f.CreatedAfter.IsZero() && w.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && w.CreatedAt.After(f.CreatedBefore) {
+		return false
+	}
+	if f.AddressPrefix != "" && !strings.HasPrefix(w.Address, f.AddressPrefix) {
+		return false
+	}
+	return true
+}
+
+// PaginationParams bounds and orders a single WalletStore.Query page,
+// following pagination conventions modeled on the Taler wallet-core
+// HTTP client's PaginationParams — the same cursor/limit/order/timeout
+// shape storage.ReportQuery uses for report queries (see
+// storage.Paginate).
+type PaginationParams struct {
+	// Offset is an opaque cursor: "" starts from the beginning, and a
+	// non-empty value must be a WalletQueryResult.NextOffset a prior
+	// call returned. It is not a numeric index — see walletCursor.
+	Offset string
+
+	// Limit caps how many wallets a single call returns; it is clamped
+	// to (0, maxPageLimit], defaulting to defaultPageLimit when <= 0.
+	Limit int
+
+	// Order sorts by CreatedAt; "" defaults to OrderAsc.
+	Order Order
+
+	// TimeoutMs, if > 0, tells ListWallets to long-poll for up to this
+	// many milliseconds when the page would otherwise come back empty,
+	// instead of returning immediately. WalletStore.Query itself treats
+	// this as informational only — long-polling is handled by the
+	// caller retrying Query (see ListWallets).
+	TimeoutMs int
+}
+
+// WalletQueryResult is one page of a WalletStore.Query call.
+type WalletQueryResult struct {
+	Items      []Wallet
+	NextOffset string
+	HasMore    bool
+}
+
+// WalletStore persists Wallet records and, per wallet and per BIP-44
+// chain (0 = external/receive, 1 = internal/change), the
+// next-unused-address-index counter GeneratePaymentAddress and
+// RecoverFromMnemonic advance.
+type WalletStore interface {
+	Save(ctx context.Context, w Wallet) error
+	Get(ctx context.Context, id string) (Wallet, error)
+	GetByAddress(ctx context.Context, address string) (Wallet, error)
+
+	// Query returns one page of wallets matching filter, ordered and
+	// bounded by page (see PaginationParams). A database-backed
+	// implementation should push filter and page down into its own
+	// WHERE/ORDER BY/LIMIT clause rather than loading every matching
+	// wallet into memory — see MemoryWalletStore.Query, which can't
+	// avoid the in-memory sort since its dataset is already in memory.
+	Query(ctx context.Context, filter WalletFilter, page PaginationParams) (WalletQueryResult, error)
+
+	// NextAddressIndex atomically reads and increments walletID's
+	// stored next-unused index on the given BIP-44 chain (starting at
+	// 0), so concurrent GeneratePaymentAddress calls for the same
+	// wallet never derive the same address twice.
+	NextAddressIndex(ctx context.Context, walletID string, chain uint32) (uint32, error)
+
+	// CurrentAddressIndex reads walletID's stored next-unused index on
+	// chain without advancing it, so a caller like RescanBlockchain can
+	// size its derived-address window around how many addresses a
+	// wallet has actually handed out.
+	CurrentAddressIndex(ctx context.Context, walletID string, chain uint32) (uint32, error)
+
+	// SetNextAddressIndex sets walletID's next-unused index on chain
+	// directly, rather than advancing it by one. RecoverFromMnemonic
+	// uses this to fast-forward a recovered wallet's counters past the
+	// addresses gap-limit discovery found already in use.
+	SetNextAddressIndex(ctx context.Context, walletID string, chain uint32, index uint32) error
+}
+
+// MemoryWalletStore is an in-process WalletStore backed by a map,
+// matching notification.MemoryStore's role for this package: fine for a
+// single instance or for tests, not for a multi-instance deployment.
+type MemoryWalletStore struct {
+	mu        sync.Mutex
+	wallets   map[string]Wallet
+	addresses map[string]string          // address -> wallet ID
+	nextIndex map[addressIndexKey]uint32 // (wallet ID, chain) -> next unused address index
+}
+
+// addressIndexKey identifies one wallet's counter on one BIP-44 chain
+// (0 = external/receive, 1 = internal/change).
+type addressIndexKey struct {
+	walletID string
+	chain    uint32
+}
+
+// NewMemoryWalletStore creates an empty MemoryWalletStore.
+func NewMemoryWalletStore() *MemoryWalletStore {
+	return &MemoryWalletStore{
+		wallets:   make(map[string]Wallet),
+		addresses: make(map[string]string),
+		nextIndex: make(map[addressIndexKey]uint32),
+	}
+}
+
+func (s *MemoryWalletStore) Save(ctx context.Context, w Wallet) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.wallets[w.ID] = w
+	if w.Address != "" {
+		s.addresses[w.Address] = w.ID
+	}
+	return nil
+}
+
+func (s *MemoryWalletStore) Get(ctx context.Context, id string) (Wallet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.wallets[id]
+	if !ok {
+		return Wallet{}, fmt.Errorf("wallet: %s not found", id)
+	}
+	return w, nil
+}
+
+func (s *MemoryWalletStore) GetByAddress(ctx context.Context, address string) (Wallet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, ok := s.addresses[address]
+	if !ok {
+		return Wallet{}, fmt.Errorf("wallet: no wallet has address %s", address)
+	}
+	return s.wallets[id], nil
+}
+
+// Query implements WalletStore. It sorts every wallet currently held
+// (there being no index to push an ORDER BY/LIMIT down into, unlike a
+// real database-backed store), then filters, cursor-skips, and trims to
+// one page — mirroring notification.MemoryStore.Query's approach for
+// NotificationStore.
+func (s *MemoryWalletStore) Query(ctx context.Context, filter WalletFilter, page PaginationParams) (WalletQueryResult, error) {
+	s.mu.Lock()
+	all := make([]Wallet, 0, len(s.wallets))
+	for _, w := range s.wallets {
+		all = append(all, w)
+	}
+	s.mu.Unlock()
+
+	order := normalizePageOrder(page.Order)
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].CreatedAt.Equal(all[j].CreatedAt) {
+			if order == OrderDesc {
+				return all[i].CreatedAt.After(all[j].CreatedAt)
+			}
+			return all[i].CreatedAt.Before(all[j].CreatedAt)
+		}
+		if order == OrderDesc {
+			return all[i].ID > all[j].ID
+		}
+		return all[i].ID < all[j].ID
+	})
+
+	cursor, err := decodeWalletCursor(page.Offset)
+	if err != nil {
+		return WalletQueryResult{}, err
+	}
+	limit := normalizePageLimit(page.Limit)
+
+	matched := make([]Wallet, 0, limit+1)
+	for _, w := range all {
+		if !filter.matches(w) {
+			continue
+		}
+		if page.Offset != "" && !isAfterWalletCursor(w, cursor, order) {
+			continue
+		}
+		matched = append(matched, w)
+		if len(matched) > limit {
+			break
+		}
+	}
+
+	var next string
+	if len(matched) > limit {
+		last := matched[limit-1]
+		next, err = encodeWalletCursor(walletCursor{LastCreatedAt: last.CreatedAt, LastID: last.ID})
+		if err != nil {
+			return WalletQueryResult{}, err
+		}
+		matched = matched[:limit]
+	}
+	return WalletQueryResult{Items: matched, NextOffset: next, HasMore: next != ""}, nil
+}
+
+// isAfterWalletCursor reports whether w comes strictly after cursor in
+// the page's sort order — i.e. whether w belongs on the page following
+// the one cursor was cut from.
+func isAfterWalletCursor(w Wallet, cursor walletCursor, order Order) bool {
+	if !w.CreatedAt.Equal(cursor.LastCreatedAt) {
+		if order == OrderDesc {
+			return w.CreatedAt.Before(cursor.LastCreatedAt)
+		}
+		return w.CreatedAt.After(cursor.LastCreatedAt)
+	}
+	if order == OrderDesc {
+		return w.ID < cursor.LastID
+	}
+	return w.ID > cursor.LastID
+}
+
+func (s *MemoryWalletStore) NextAddressIndex(ctx context.Context, walletID string, chain uint32) (uint32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.wallets[walletID]; !ok {
+		return 0, fmt.Errorf("wallet: %s not found", walletID)
+	}
+	key := addressIndexKey{walletID: walletID, chain: chain}
+	index := s.nextIndex[key]
+	s.nextIndex[key] = index + 1
+	return index, nil
+}
+
+func (s *MemoryWalletStore) CurrentAddressIndex(ctx context.Context, walletID string, chain uint32) (uint32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.wallets[walletID]; !ok {
+		return 0, fmt.Errorf("wallet: %s not found", walletID)
+	}
+	return s.nextIndex[addressIndexKey{walletID: walletID, chain: chain}], nil
+}
+
+func (s *MemoryWalletStore) SetNextAddressIndex(ctx context.Context, walletID string, chain uint32, index uint32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.wallets[walletID]; !ok {
+		return fmt.Errorf("wallet: %s not found", walletID)
+	}
+	s.nextIndex[addressIndexKey{walletID: walletID, chain: chain}] = index
+	return nil
+}
@@ -0,0 +1,27 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// UTXO is one unspent output available to fund an outgoing transaction
+// from a wallet's address.
+type UTXO struct {
+	TxID          string
+	Vout          uint32
+	Address       string
+	Amount        money.Amount
+	Confirmations int
+}
+
+// UTXOProvider looks up the unspent outputs currently controlled by a
+// wallet's addresses, so BuildUnsignedTransaction can select inputs
+// covering a requested amount plus fee. Unlike chain.FeeQuoter or
+// chain.SettlementBackend, neither of which exposes a wallet's
+// available inputs, this is specific to the wallet package's own
+// spend-construction needs.
+type UTXOProvider interface {
+	ListUTXOs(ctx context.Context, walletID string) ([]UTXO, error)
+}
@@ -1,174 +0,0 @@
-package wallet
-
-import (
-	"crypto/rand"
-	"encoding/hex"
-	"errors"
-	"time"
-)
-
-// WalletType defines the type of cryptocurrency wallet
-type WalletType string
-
-const (
-	// WalletTypeHot represents a hot wallet (online)
-	WalletTypeHot WalletType = "hot"
-	
-	// WalletTypeCold represents a cold wallet (offline)
-	WalletTypeCold WalletType = "cold"
-)
-
-// Wallet represents a cryptocurrency wallet
-type Wallet struct {
-	ID            string     `json:"id"`
-	Currency      string     `json:"currency"`
-	Address       string     `json:"address"`
-	Balance       float64    `json:"balance"`
-	Type          WalletType `json:"type"`
-	MerchantID    string     `json:"merchant_id,omitempty"`
-	CreatedAt     time.Time  `json:"created_at"`
-	UpdatedAt     time.Time  `json:"updated_at"`
-	LastSyncedAt  time.Time  `json:"last_synced_at"`
-}
-
-// WalletService handles wallet management operations
-type WalletService struct {
-	// Dependencies would be injected here in a real implementation
-	// blockchainClients, keyManagementService, etc.
-}
-
-// NewWalletService creates a new instance of WalletService
-func NewWalletService() *WalletService {
-	return &WalletService{}
-}
-
-// CreateWallet initializes a new wallet for a specific cryptocurrency
-func (s *WalletService) CreateWallet(currency string, walletType WalletType, merchantID string) (*Wallet, error) {
-	if currency == "" {
-		return nil, errors.New("currency is required")
-	}
-	
-	// Generate a unique wallet ID
-	id, err := generateWalletID()
-	if err != nil {
-		return nil, err
-	}
-	
-	// In a real implementation, we would:
-	// 1. Generate a new key pair for the wallet
-	// 2. Derive the wallet address from the public key
-	// 3. Securely store the private key in a key management system
-	// 4. Register the wallet with the blockchain client
-	
-	// For now, we'll use a placeholder address
-	address := "placeholder_" + currency + "_address"
-	
-	now := time.Now()
-	
-	wallet := &Wallet{
-		ID:           id,
-		Currency:     currency,
-		Address:      address,
-		Balance:      0,
-		Type:         walletType,
-		MerchantID:   merchantID,
-		CreatedAt:    now,
-		UpdatedAt:    now,
-		LastSyncedAt: now,
-	}
-	
-	// In a real implementation, we would persist the wallet to a database
-	
-	return wallet, nil
-}
-
-// GetWallet retrieves a wallet by ID
-func (s *WalletService) GetWallet(walletID string) (*Wallet, error) {
-	// In a real implementation, we would retrieve the wallet from the database
-	// For now, we'll return an error
-	return nil, errors.New("wallet not found")
-}
-
-// GetWalletByAddress retrieves a wallet by its address
-func (s *WalletService) GetWalletByAddress(address string) (*Wallet, error) {
-	// In a real implementation, we would retrieve the wallet from the database
-	// For now, we'll return an error
-	return nil, errors.New("wallet not found")
-}
-
-// ListWallets retrieves wallets based on filters
-func (s *WalletService) ListWallets(merchantID string, currency string) ([]*Wallet, error) {
-	// In a real implementation, we would query the database with the provided filters
-	// For now, we'll return an empty slice
-	return []*Wallet{}, nil
-}
-
-// UpdateWalletBalance updates the balance of a wallet
-func (s *WalletService) UpdateWalletBalance(walletID string, newBalance float64) error {
-	if newBalance < 0 {
-		return errors.New("balance cannot be negative")
-	}
-	
-	// In a real implementation, we would:
-	// 1. Retrieve the wallet from the database
-	// 2. Update the balance
-	// 3. Update the lastSyncedAt timestamp
-	// 4. Persist the changes
-	
-	return nil
-}
-
-// SyncWalletBalance synchronizes the wallet balance with the blockchain
-func (s *WalletService) SyncWalletBalance(walletID string) (float64, error) {
-	// In a real implementation, we would:
-	// 1. Retrieve the wallet from the database
-	// 2. Query the blockchain for the current balance
-	// 3. Update the wallet balance
-	// 4. Update the lastSyncedAt timestamp
-	// 5. Persist the changes
-	
-	// For now, we'll return a placeholder value
-	return 0, nil
-}
-
-// GeneratePaymentAddress creates a new address for receiving payments
-func (s *WalletService) GeneratePaymentAddress(walletID string) (string, error) {
-	// In a real implementation, we would:
-	// 1. Retrieve the wallet from the database
-	// 2. Generate a new address using the blockchain client
-	// 3. Associate the address with the wallet
-	// 4. Persist the changes
-	
-	// For now, we'll return a placeholder address
-	return "new_payment_address", nil
-}
-
-// TransferFunds moves funds between wallets
-func (s *WalletService) TransferFunds(sourceWalletID string, destinationAddress string, amount float64, fee float64) (string, error) {
-	if amount <= 0 {
-		return "", errors.New("amount must be greater than zero")
-	}
-	
-	if fee < 0 {
-		return "", errors.New("fee cannot be negative")
-	}
-	
-	// In a real implementation, we would:
-	// 1. Retrieve the source wallet from the database
-	// 2. Verify the wallet has sufficient balance
-	// 3. Create and sign a transaction using the blockchain client
-	// 4. Broadcast the transaction to the network
-	// 5. Return the transaction ID
-	
-	// For now, we'll return a placeholder transaction ID
-	return "placeholder_transaction_id", nil
-}
-
-// generateWalletID creates a unique wallet identifier
-func generateWalletID() (string, error) {
-	bytes := make([]byte, 16)
-	if _, err := rand.Read(bytes); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(bytes), nil
-}
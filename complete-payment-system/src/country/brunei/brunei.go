@@ -0,0 +1,314 @@
+package brunei
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// BruneiModule implements country-specific regulatory compliance for Brunei
+type BruneiModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+
+	// Brunei-specific fields
+	AMBDNotified bool // Whether the merchant has notified Autoriti Monetari Brunei Darussalam
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewBruneiModule creates a new instance of BruneiModule
+func NewBruneiModule() *BruneiModule {
+	return &BruneiModule{
+		CountryCode: "BN",
+		CountryName: "Brunei Darussalam",
+		Regulators: []string{
+			"Autoriti Monetari Brunei Darussalam (AMBD)",
+		},
+		AMBDNotified: false, // Default to false, should be set based on merchant notification status
+	}
+}
+
+func init() {
+	compliance.Register("BN", func() compliance.Module { return NewBruneiModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Brunei regulations
+func (m *BruneiModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *BruneiModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant has notified AMBD
+	if !m.AMBDNotified {
+		return false, errors.New("merchant has not notified Autoriti Monetari Brunei Darussalam")
+	}
+
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Brunei")
+	}
+
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Brunei")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *BruneiModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Autoriti Monetari Brunei Darussalam (AMBD)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// GenerateReports creates regulatory reports for the period and
+// pagination parameters described by query.
+func (m *BruneiModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Brunei module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("BND")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		report := map[string]interface{}{
+			"report_type":       "AMBD_Notification",
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Brunei's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *BruneiModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Brunei module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Brunei
+func (m *BruneiModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	// Brunei has limited specific regulations, so these are conservative estimates
+	bnd := money.MustGetCurrency("BND")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(bnd, 5000000), // 50,000 BND per day
+		"monthly": money.NewFromMinorInt64(bnd, 50000000), // 500,000 BND per month
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Brunei.
+func (m *BruneiModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("BND"), 1000000))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Brunei
+func (m *BruneiModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// Brunei does not have a capital gains tax or income tax on cryptocurrency
+	// Returns 0 tax amount
+	return money.Zero(tx.Amount.Currency()), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Brunei
+func (m *BruneiModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Brunei has no official list, so this is based on commonly accepted cryptocurrencies
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"USDT", // Tether
+		"BNB",  // Binance Coin
+	}
+}
+
+// bruneiStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var bruneiStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for Brunei. AMBD has
+// not published a formal risk-tier taxonomy like NBC's or OJK's, so
+// this only distinguishes stablecoins from other supported assets.
+func (m *BruneiModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if bruneiStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for Brunei
+func (m *BruneiModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *BruneiModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Brunei
+func (m *BruneiModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Brunei
+func (m *BruneiModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	bnd := money.MustGetCurrency("BND")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"National ID or Passport",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+		},
+		"business": []string{
+			"Business Name",
+			"Business Registration Number",
+			"Business Address",
+			"Director Information",
+			"Shareholder Information",
+			"Source of Funds",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(bnd, 1000000), // BND, threshold for enhanced due diligence
+	}
+}
+
+// GenerateWarningNotice creates a warning notice for users as required by AMBD
+func (m *BruneiModule) GenerateWarningNotice() string {
+	return `WARNING: Cryptocurrencies are not legal tender in Brunei Darussalam and are not regulated by Autoriti Monetari Brunei Darussalam (AMBD). Investments in cryptocurrency products involve risks including the potential loss of principal. Investors should be aware that cryptocurrency values can fluctuate and past performance is not indicative of future results.`
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *BruneiModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
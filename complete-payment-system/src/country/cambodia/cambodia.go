@@ -0,0 +1,369 @@
+package cambodia
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/stablecoin"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// CambodiaModule implements country-specific regulatory compliance for Cambodia
+type CambodiaModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+
+	// Cambodia-specific fields
+	NBCLicensed bool // Whether the merchant is licensed by National Bank of Cambodia
+	CryptoGroup string // Group classification of cryptoassets (Group 1a, 1b, or 2)
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+
+	// Stablecoins, when set, is consulted before accepting a Group 1b
+	// (stablecoin) transaction: ValidateTransaction rejects it unless
+	// Stablecoins.Evaluate finds a sufficiently fresh, pegged, and
+	// reserved attestation for the asset. Leave nil to fall back to
+	// trusting ClassifyCryptoAsset's classification alone.
+	Stablecoins *stablecoin.Cache
+}
+
+// NewCambodiaModule creates a new instance of CambodiaModule
+func NewCambodiaModule() *CambodiaModule {
+	return &CambodiaModule{
+		CountryCode: "KH",
+		CountryName: "Cambodia",
+		Regulators: []string{
+			"National Bank of Cambodia (NBC)",
+		},
+		NBCLicensed: false, // Default to false, should be set based on merchant license status
+		CryptoGroup: "",    // Should be set based on cryptoasset classification
+	}
+}
+
+func init() {
+	compliance.Register("KH", func() compliance.Module { return NewCambodiaModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Cambodian regulations
+func (m *CambodiaModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *CambodiaModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is licensed by NBC
+	if !m.NBCLicensed {
+		return false, errors.New("merchant is not licensed by National Bank of Cambodia")
+	}
+
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Cambodia")
+	}
+
+	// Check cryptoasset group restrictions
+	group, err := m.ClassifyCryptoAsset(tx.CryptoCurrency)
+	if err != nil {
+		return false, err
+	}
+
+	// Commercial banks may only provide services for Group 1 cryptoassets
+	if m.CryptoGroup == "commercial_bank" && group == "Group 2" {
+		return false, errors.New("commercial banks may not provide services for Group 2 cryptoassets")
+	}
+
+	// Group 1b is NBC's stablecoin classification; verify the issuer's
+	// reserve attestation actually backs it rather than trusting the
+	// classification alone.
+	if group == "Group 1b" && m.Stablecoins != nil {
+		if err := m.Stablecoins.Evaluate(tx.CryptoCurrency); err != nil {
+			return false, err
+		}
+	}
+
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Cambodia")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *CambodiaModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "National Bank of Cambodia (NBC)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// GenerateReports creates regulatory reports for the specified time period
+func (m *CambodiaModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Cambodia module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("KHR")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		report := map[string]interface{}{
+			"report_type":       "NBC_Quarterly_Report",
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Cambodia's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *CambodiaModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Cambodia module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Cambodia
+func (m *CambodiaModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	khr := money.MustGetCurrency("KHR")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(khr, 4000000000),  // 40,000,000 KHR per day (approx. $10,000 USD)
+		"monthly": money.NewFromMinorInt64(khr, 40000000000), // 400,000,000 KHR per month (approx. $100,000 USD)
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Cambodia.
+func (m *CambodiaModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("KHR"), 400000000))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Cambodia
+func (m *CambodiaModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// In Cambodia, cryptocurrency transactions may be subject to income tax
+	// at the standard rate of 20%
+	taxRate := big.NewRat(20, 100)
+
+	return tx.Amount.Mul(taxRate), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Cambodia
+func (m *CambodiaModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on NBC's regulations, though no official list exists
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"USDT", // Tether
+		"USDC", // USD Coin
+		"BNB",  // Binance Coin
+	}
+}
+
+// GetCountryCode returns the ISO country code for Cambodia
+func (m *CambodiaModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *CambodiaModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Cambodia
+func (m *CambodiaModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Cambodia
+func (m *CambodiaModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	khr := money.MustGetCurrency("KHR")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"National ID or Passport",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Occupation",
+		},
+		"business": []string{
+			"Business Name",
+			"Business Registration Number",
+			"Business Address",
+			"Director Information",
+			"Shareholder Information",
+			"Source of Funds",
+			"Business Activities",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(khr, 400000000), // KHR, threshold for enhanced due diligence (approx. $1,000 USD)
+	}
+}
+
+// ClassifyCryptoAsset classifies a cryptocurrency according to NBC regulations
+func (m *CambodiaModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	// In Cambodia, cryptoassets are classified into groups:
+	// Group 1: Digital representation of traditional financial instruments or assets
+	//   Group 1a: Tokenized securities
+	//   Group 1b: Stablecoins
+	// Group 2: All cryptoassets that don't meet Group 1 criteria (unbacked cryptoassets)
+
+	group1b := []string{"USDT", "USDC", "BUSD", "DAI"}
+	for _, crypto := range group1b {
+		if crypto == cryptoCurrency {
+			return "Group 1b", nil
+		}
+	}
+
+	group1a := []string{"LINK", "UNI", "AAVE"}
+	for _, crypto := range group1a {
+		if crypto == cryptoCurrency {
+			return "Group 1a", nil
+		}
+	}
+
+	// Check if it's a supported cryptocurrency
+	supported := m.GetSupportedCryptocurrencies()
+	for _, crypto := range supported {
+		if crypto == cryptoCurrency {
+			return "Group 2", nil
+		}
+	}
+
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// CalculateExposureLimits calculates the exposure limits for a commercial bank
+func (m *CambodiaModule) CalculateExposureLimits(cet1Capital money.Amount) map[string]money.Amount {
+	// According to NBC regulations:
+	// - Group 1a exposures must not exceed 5% of CET1 Capital
+	// - Group 1b exposures must not exceed 3% of CET1 Capital
+
+	return map[string]money.Amount{
+		"Group 1a": cet1Capital.Mul(big.NewRat(5, 100)),
+		"Group 1b": cet1Capital.Mul(big.NewRat(3, 100)),
+		"Group 2":  money.Zero(cet1Capital.Currency()), // Commercial banks cannot have Group 2 exposures
+	}
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *CambodiaModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
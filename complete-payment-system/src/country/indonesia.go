@@ -1,227 +0,0 @@
-package indonesia
-
-import (
-	"errors"
-	"time"
-)
-
-// Transaction represents a cryptocurrency payment transaction
-type Transaction struct {
-	ID               string
-	Amount           float64
-	Currency         string
-	CryptoCurrency   string
-	SourceAddress    string
-	DestinationAddress string
-	Status           string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	CompletedAt      time.Time
-	MerchantID       string
-	CustomerID       string
-	CountryCode      string
-}
-
-// IndonesiaModule implements country-specific regulatory compliance for Indonesia
-type IndonesiaModule struct {
-	CountryCode string
-	CountryName string
-	Regulators  []string
-	
-	// Indonesia-specific fields
-	BappebtiRegistered bool // Whether the merchant is registered with Commodity Futures Trading Regulatory Agency
-	OJKCompliant bool      // Whether the merchant complies with Financial Services Authority regulations
-}
-
-// NewIndonesiaModule creates a new instance of IndonesiaModule
-func NewIndonesiaModule() *IndonesiaModule {
-	return &IndonesiaModule{
-		CountryCode: "ID",
-		CountryName: "Indonesia",
-		Regulators: []string{
-			"Commodity Futures Trading Regulatory Agency (Bappebti)",
-			"Financial Services Authority (OJK)",
-			"Bank Indonesia (BI)",
-		},
-		BappebtiRegistered: false, // Default to false, should be set based on merchant registration
-		OJKCompliant: false,       // Default to false, should be set based on merchant compliance
-	}
-}
-
-// ValidateTransaction checks if a transaction complies with Indonesian regulations
-func (m *IndonesiaModule) ValidateTransaction(transaction interface{}) (bool, error) {
-	tx, ok := transaction.(*Transaction)
-	if !ok {
-		return false, errors.New("invalid transaction type")
-	}
-	
-	// Check if the merchant is registered with Bappebti
-	if !m.BappebtiRegistered {
-		return false, errors.New("merchant is not registered with Bappebti")
-	}
-	
-	// Check if the merchant complies with OJK regulations
-	if !m.OJKCompliant {
-		return false, errors.New("merchant does not comply with OJK regulations")
-	}
-	
-	// Check transaction limits
-	limits := m.GetTransactionLimits()
-	if tx.Amount > limits["daily"] {
-		return false, errors.New("transaction exceeds daily limit")
-	}
-	
-	// Check if cryptocurrency is supported
-	supported := m.GetSupportedCryptocurrencies()
-	cryptoSupported := false
-	for _, crypto := range supported {
-		if crypto == tx.CryptoCurrency {
-			cryptoSupported = true
-			break
-		}
-	}
-	
-	if !cryptoSupported {
-		return false, errors.New("cryptocurrency not supported in Indonesia")
-	}
-	
-	// All checks passed
-	return true, nil
-}
-
-// GenerateReports creates regulatory reports for the specified time period
-func (m *IndonesiaModule) GenerateReports(startTime, endTime interface{}) ([]interface{}, error) {
-	// In a real implementation, we would:
-	// 1. Query transactions for the specified time period
-	// 2. Format the data according to Bappebti and OJK requirements
-	// 3. Generate the required reports
-	
-	// For now, we'll return a placeholder
-	return []interface{}{
-		map[string]string{
-			"report_type": "Bappebti_Monthly_Report",
-			"period": "2025-03",
-			"status": "generated",
-		},
-		map[string]string{
-			"report_type": "OJK_Quarterly_Report",
-			"period": "2025-Q1",
-			"status": "generated",
-		},
-	}, nil
-}
-
-// GetTransactionLimits returns the transaction limits for Indonesia
-func (m *IndonesiaModule) GetTransactionLimits() map[string]float64 {
-	return map[string]float64{
-		"daily":   100000000.0,  // 100,000,000 IDR per day
-		"monthly": 1000000000.0, // 1,000,000,000 IDR per month
-	}
-}
-
-// CalculateTax calculates applicable taxes for a transaction in Indonesia
-func (m *IndonesiaModule) CalculateTax(transaction interface{}) (float64, error) {
-	tx, ok := transaction.(*Transaction)
-	if !ok {
-		return 0, errors.New("invalid transaction type")
-	}
-	
-	// In Indonesia, cryptocurrency transactions are subject to income tax at 0.1%
-	// as per January 2025 regulations
-	taxRate := 0.001
-	
-	return tx.Amount * taxRate, nil
-}
-
-// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Indonesia
-func (m *IndonesiaModule) GetSupportedCryptocurrencies() []string {
-	// Based on Bappebti's approved list of crypto assets
-	return []string{
-		"BTC",  // Bitcoin
-		"ETH",  // Ethereum
-		"USDT", // Tether
-		"BNB",  // Binance Coin
-		"ADA",  // Cardano
-		"XRP",  // Ripple
-		"DOGE", // Dogecoin
-		"DOT",  // Polkadot
-		"LINK", // Chainlink
-		"UNI",  // Uniswap
-		"MATIC", // Polygon
-	}
-}
-
-// GetCountryCode returns the ISO country code for Indonesia
-func (m *IndonesiaModule) GetCountryCode() string {
-	return m.CountryCode
-}
-
-// GetCountryName returns the country name
-func (m *IndonesiaModule) GetCountryName() string {
-	return m.CountryName
-}
-
-// GetRegulators returns the list of regulatory authorities in Indonesia
-func (m *IndonesiaModule) GetRegulators() []string {
-	return m.Regulators
-}
-
-// GetKYCRequirements returns the KYC requirements for Indonesia
-func (m *IndonesiaModule) GetKYCRequirements() map[string]interface{} {
-	return map[string]interface{}{
-		"individual": []string{
-			"Full Name",
-			"National ID Number (KTP)",
-			"Tax Identification Number (NPWP)",
-			"Date of Birth",
-			"Residential Address",
-			"Contact Information",
-			"Source of Funds",
-			"Occupation",
-		},
-		"business": []string{
-			"Business Name",
-			"Business Registration Number (NIB)",
-			"Tax Identification Number (NPWP)",
-			"Business Address",
-			"Director Information",
-			"Shareholder Information",
-			"Source of Funds",
-			"Business Activities",
-		},
-		"transaction_threshold": 10000000.0, // IDR, threshold for enhanced due diligence
-	}
-}
-
-// ClassifyCryptoAsset classifies a cryptocurrency according to OJK regulations
-func (m *IndonesiaModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
-	// In Indonesia, cryptoassets are classified into groups:
-	// Group 1: Digital representation of traditional assets
-	//   Group 1a: Tokenized securities
-	//   Group 1b: Stablecoins
-	// Group 2: Unbacked cryptoassets
-	
-	group1b := []string{"USDT", "USDC", "BUSD", "DAI"}
-	for _, crypto := range group1b {
-		if crypto == cryptoCurrency {
-			return "Group 1b (Stablecoin)", nil
-		}
-	}
-	
-	group1a := []string{"LINK", "UNI", "AAVE"}
-	for _, crypto := range group1a {
-		if crypto == cryptoCurrency {
-			return "Group 1a (Tokenized Security)", nil
-		}
-	}
-	
-	// Check if it's a supported cryptocurrency
-	supported := m.GetSupportedCryptocurrencies()
-	for _, crypto := range supported {
-		if crypto == cryptoCurrency {
-			return "Group 2 (Unbacked Cryptoasset)", nil
-		}
-	}
-	
-	return "", errors.New("unsupported cryptocurrency")
-}
@@ -0,0 +1,356 @@
+package indonesia
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// IndonesiaModule implements country-specific regulatory compliance for Indonesia
+type IndonesiaModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+	
+	// Indonesia-specific fields
+	BappebtiRegistered bool // Whether the merchant is registered with Commodity Futures Trading Regulatory Agency
+	OJKCompliant bool      // Whether the merchant complies with Financial Services Authority regulations
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewIndonesiaModule creates a new instance of IndonesiaModule
+func NewIndonesiaModule() *IndonesiaModule {
+	return &IndonesiaModule{
+		CountryCode: "ID",
+		CountryName: "Indonesia",
+		Regulators: []string{
+			"Commodity Futures Trading Regulatory Agency (Bappebti)",
+			"Financial Services Authority (OJK)",
+			"Bank Indonesia (BI)",
+		},
+		BappebtiRegistered: false, // Default to false, should be set based on merchant registration
+		OJKCompliant: false,       // Default to false, should be set based on merchant compliance
+	}
+}
+
+func init() {
+	compliance.Register("ID", func() compliance.Module { return NewIndonesiaModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Indonesian regulations
+func (m *IndonesiaModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *IndonesiaModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is registered with Bappebti
+	if !m.BappebtiRegistered {
+		return false, errors.New("merchant is not registered with Bappebti")
+	}
+	
+	// Check if the merchant complies with OJK regulations
+	if !m.OJKCompliant {
+		return false, errors.New("merchant does not comply with OJK regulations")
+	}
+	
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+	
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+	
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Indonesia")
+	}
+	
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Indonesia")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *IndonesiaModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Commodity Futures Trading Regulatory Agency (Bappebti)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// indonesiaReportTypes maps each Indonesian regulator to the report type
+// GenerateReports submits to it.
+var indonesiaReportTypes = map[string]string{
+	"Commodity Futures Trading Regulatory Agency (Bappebti)": "Bappebti_Monthly_Report",
+	"Financial Services Authority (OJK)":                     "OJK_Quarterly_Report",
+	"Bank Indonesia (BI)":                                    "BI_Quarterly_Report",
+}
+
+// GenerateReports creates regulatory reports for the specified time period
+func (m *IndonesiaModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Indonesia module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("IDR")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		reportType, ok := indonesiaReportTypes[regulator]
+		if !ok {
+			reportType = "Regulatory_Report"
+		}
+
+		report := map[string]interface{}{
+			"report_type":       reportType,
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Indonesia's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *IndonesiaModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Indonesia module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Indonesia
+func (m *IndonesiaModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	idr := money.MustGetCurrency("IDR")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(idr, 10000000000),  // 100,000,000 IDR per day
+		"monthly": money.NewFromMinorInt64(idr, 100000000000), // 1,000,000,000 IDR per month
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Indonesia.
+func (m *IndonesiaModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("IDR"), 1000000000))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Indonesia
+func (m *IndonesiaModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// In Indonesia, cryptocurrency transactions are subject to income tax at 0.1%
+	// as per January 2025 regulations
+	taxRate := big.NewRat(1, 1000)
+
+	return tx.Amount.Mul(taxRate), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Indonesia
+func (m *IndonesiaModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on Bappebti's approved list of crypto assets
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"USDT", // Tether
+		"BNB",  // Binance Coin
+		"ADA",  // Cardano
+		"XRP",  // Ripple
+		"DOGE", // Dogecoin
+		"DOT",  // Polkadot
+		"LINK", // Chainlink
+		"UNI",  // Uniswap
+		"MATIC", // Polygon
+	}
+}
+
+// GetCountryCode returns the ISO country code for Indonesia
+func (m *IndonesiaModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *IndonesiaModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Indonesia
+func (m *IndonesiaModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Indonesia
+func (m *IndonesiaModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	idr := money.MustGetCurrency("IDR")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"National ID Number (KTP)",
+			"Tax Identification Number (NPWP)",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Occupation",
+		},
+		"business": []string{
+			"Business Name",
+			"Business Registration Number (NIB)",
+			"Tax Identification Number (NPWP)",
+			"Business Address",
+			"Director Information",
+			"Shareholder Information",
+			"Source of Funds",
+			"Business Activities",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(idr, 1000000000), // IDR, threshold for enhanced due diligence
+	}
+}
+
+// ClassifyCryptoAsset classifies a cryptocurrency according to OJK regulations
+func (m *IndonesiaModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	// In Indonesia, cryptoassets are classified into groups:
+	// Group 1: Digital representation of traditional assets
+	//   Group 1a: Tokenized securities
+	//   Group 1b: Stablecoins
+	// Group 2: Unbacked cryptoassets
+	
+	group1b := []string{"USDT", "USDC", "BUSD", "DAI"}
+	for _, crypto := range group1b {
+		if crypto == cryptoCurrency {
+			return "Group 1b (Stablecoin)", nil
+		}
+	}
+	
+	group1a := []string{"LINK", "UNI", "AAVE"}
+	for _, crypto := range group1a {
+		if crypto == cryptoCurrency {
+			return "Group 1a (Tokenized Security)", nil
+		}
+	}
+	
+	// Check if it's a supported cryptocurrency
+	supported := m.GetSupportedCryptocurrencies()
+	for _, crypto := range supported {
+		if crypto == cryptoCurrency {
+			return "Group 2 (Unbacked Cryptoasset)", nil
+		}
+	}
+	
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *IndonesiaModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
@@ -0,0 +1,319 @@
+package japan
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// JapanModule implements country-specific regulatory compliance for Japan
+type JapanModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+
+	// Japan-specific fields
+	FSARegistered bool // Whether the merchant is registered with the Financial Services Agency as a crypto asset exchange
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewJapanModule creates a new instance of JapanModule
+func NewJapanModule() *JapanModule {
+	return &JapanModule{
+		CountryCode: "JP",
+		CountryName: "Japan",
+		Regulators: []string{
+			"Financial Services Agency (FSA)",
+		},
+		FSARegistered: false, // Default to false, should be set based on merchant registration status
+	}
+}
+
+func init() {
+	compliance.Register("JP", func() compliance.Module { return NewJapanModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Japanese regulations
+func (m *JapanModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *JapanModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is registered with the FSA
+	if !m.FSARegistered {
+		return false, errors.New("merchant is not registered with the Financial Services Agency as a crypto asset exchange")
+	}
+
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Japan")
+	}
+
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Japan")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *JapanModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Financial Services Agency (FSA)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// GenerateReports creates regulatory reports for the period and
+// pagination parameters described by query.
+func (m *JapanModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Japan module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("JPY")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		report := map[string]interface{}{
+			"report_type":       "FSA_Crypto_Asset_Report",
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Japan's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *JapanModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Japan module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Japan
+func (m *JapanModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	jpy := money.MustGetCurrency("JPY")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(jpy, 2000000),  // 2,000,000 JPY per day
+		"monthly": money.NewFromMinorInt64(jpy, 20000000), // 20,000,000 JPY per month
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Japan.
+// The JFSA/JVCEA implemented the Travel Rule in 2023 with no de
+// minimis threshold, but this repo follows the same above-threshold
+// gating pattern as the other modules rather than treating JPY 0 as
+// a special case.
+func (m *JapanModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("JPY"), 0))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Japan
+func (m *JapanModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// Cryptocurrency gains in Japan are taxed as miscellaneous income at
+	// the filer's marginal rate (up to 45% national plus 10% local); this
+	// placeholder uses the top combined rate pending per-filer bracket data.
+	taxRate := big.NewRat(55, 100)
+
+	return tx.Amount.Mul(taxRate), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Japan
+func (m *JapanModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on assets commonly listed by JFSA-registered exchanges
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"XRP",  // Ripple
+		"USDT", // Tether
+		"BNB",  // Binance Coin
+	}
+}
+
+// japanStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var japanStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for Japan. The FSA's
+// registered-exchange regime doesn't tier individual assets by risk,
+// so this only distinguishes stablecoins from other supported assets.
+func (m *JapanModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if japanStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for Japan
+func (m *JapanModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *JapanModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Japan
+func (m *JapanModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Japan
+func (m *JapanModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	jpy := money.MustGetCurrency("JPY")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"My Number (Individual Number) or Passport",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Occupation",
+		},
+		"business": []string{
+			"Business Name",
+			"Corporate Number",
+			"Business Address",
+			"Representative Information",
+			"Beneficial Ownership Information",
+			"Source of Funds",
+			"Business Activities",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(jpy, 2000000), // JPY, threshold for enhanced due diligence
+	}
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *JapanModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
@@ -0,0 +1,355 @@
+package laos
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// LaosModule implements country-specific regulatory compliance for Laos
+type LaosModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+	
+	// Laos-specific fields
+	BOLLicensed bool // Whether the merchant is licensed by Bank of the Lao PDR
+	PilotParticipant bool // Whether the merchant is part of the pilot program
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewLaosModule creates a new instance of LaosModule
+func NewLaosModule() *LaosModule {
+	return &LaosModule{
+		CountryCode: "LA",
+		CountryName: "Lao People's Democratic Republic",
+		Regulators: []string{
+			"Bank of the Lao PDR (BOL)",
+		},
+		BOLLicensed: false, // Default to false, should be set based on merchant license status
+		PilotParticipant: false, // Default to false, should be set based on pilot program participation
+	}
+}
+
+func init() {
+	compliance.Register("LA", func() compliance.Module { return NewLaosModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Laotian regulations
+func (m *LaosModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *LaosModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is licensed by BOL
+	if !m.BOLLicensed {
+		return false, errors.New("merchant is not licensed by Bank of the Lao PDR")
+	}
+	
+	// Check if the merchant is part of the pilot program
+	if !m.PilotParticipant {
+		return false, errors.New("merchant is not part of the cryptocurrency pilot program")
+	}
+	
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+	
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+	
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Laos")
+	}
+	
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Laos")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *LaosModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Bank of the Lao PDR (BOL)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// GenerateReports creates regulatory reports for the specified time period
+func (m *LaosModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Laos module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("LAK")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		report := map[string]interface{}{
+			"report_type":       "BOL_Pilot_Program_Report",
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Laos's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *LaosModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Laos module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Laos
+func (m *LaosModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	lak := money.MustGetCurrency("LAK")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(lak, 5000000000),  // 50,000,000 LAK per day (approx. $2,500 USD)
+		"monthly": money.NewFromMinorInt64(lak, 50000000000), // 500,000,000 LAK per month (approx. $25,000 USD)
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Laos.
+func (m *LaosModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("LAK"), 1000000000))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Laos
+func (m *LaosModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// In Laos, cryptocurrency transactions may be subject to income tax
+	// at the standard rate of 24%
+	taxRate := big.NewRat(24, 100)
+
+	return tx.Amount.Mul(taxRate), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Laos
+func (m *LaosModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on the pilot program, though no official list exists
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"USDT", // Tether
+	}
+}
+
+// laosStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var laosStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for Laos. The pilot
+// program hasn't published a formal risk-tier taxonomy, so this only
+// distinguishes stablecoins from other supported assets.
+func (m *LaosModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if laosStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for Laos
+func (m *LaosModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *LaosModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Laos
+func (m *LaosModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Laos
+func (m *LaosModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	lak := money.MustGetCurrency("LAK")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"National ID or Passport",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Occupation",
+		},
+		"business": []string{
+			"Business Name",
+			"Business Registration Number",
+			"Business Address",
+			"Director Information",
+			"Shareholder Information",
+			"Source of Funds",
+			"Business Activities",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(lak, 1000000000), // LAK, threshold for enhanced due diligence (approx. $500 USD)
+	}
+}
+
+// CheckPilotStatus checks if the pilot program is still active
+func (m *LaosModule) CheckPilotStatus() (bool, error) {
+	// The pilot program in Laos started in September 2021 and was set to last three years
+	// Check if we're still within the pilot period or if it has been extended
+	
+	pilotStartDate := time.Date(2021, time.September, 9, 0, 0, 0, 0, time.UTC)
+	originalEndDate := pilotStartDate.AddDate(3, 0, 0) // 3 years from start date
+	extendedEndDate := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC) // Extended to end of 2025
+	
+	currentTime := time.Now()
+	
+	if currentTime.After(extendedEndDate) {
+		return false, errors.New("the cryptocurrency pilot program in Laos has ended")
+	}
+	
+	if currentTime.After(originalEndDate) {
+		return true, nil // Still active under the extension
+	}
+	
+	return true, nil // Still active under the original pilot period
+}
+
+// CheckActivityStatus checks if the merchant's operations are active
+func (m *LaosModule) CheckActivityStatus(lastActivityDate time.Time) (bool, error) {
+	// In November 2023, Laos announced it would suspend business operations of companies
+	// that fail to make progress or fulfill agreements
+	
+	currentTime := time.Now()
+	inactivityThreshold := 90 * 24 * time.Hour // 90 days
+	
+	if currentTime.Sub(lastActivityDate) > inactivityThreshold {
+		return false, errors.New("merchant may be subject to suspension due to inactivity")
+	}
+	
+	return true, nil
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *LaosModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
@@ -0,0 +1,328 @@
+package malaysia
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// MalaysiaModule implements country-specific regulatory compliance for Malaysia
+type MalaysiaModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+	
+	// Malaysia-specific fields
+	SCLicensed bool // Whether the merchant is licensed by Securities Commission Malaysia
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewMalaysiaModule creates a new instance of MalaysiaModule
+func NewMalaysiaModule() *MalaysiaModule {
+	return &MalaysiaModule{
+		CountryCode: "MY",
+		CountryName: "Malaysia",
+		Regulators: []string{
+			"Securities Commission Malaysia (SC)",
+			"Bank Negara Malaysia (BNM)",
+		},
+		SCLicensed: false, // Default to false, should be set based on merchant registration
+	}
+}
+
+func init() {
+	compliance.Register("MY", func() compliance.Module { return NewMalaysiaModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Malaysian regulations
+func (m *MalaysiaModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *MalaysiaModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is licensed by SC (required for crypto trading in Malaysia)
+	if !m.SCLicensed {
+		return false, errors.New("merchant is not licensed by Securities Commission Malaysia")
+	}
+	
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+	
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+	
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Malaysia")
+	}
+	
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Malaysia")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *MalaysiaModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Securities Commission Malaysia (SC)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// malaysiaReportTypes maps each Malaysian regulator to the report type
+// GenerateReports submits to it.
+var malaysiaReportTypes = map[string]string{
+	"Securities Commission Malaysia (SC)": "SC_Monthly_Report",
+	"Bank Negara Malaysia (BNM)":          "BNM_Quarterly_Report",
+}
+
+// GenerateReports creates regulatory reports for the specified time period
+func (m *MalaysiaModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Malaysia module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("MYR")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		reportType, ok := malaysiaReportTypes[regulator]
+		if !ok {
+			reportType = "Regulatory_Report"
+		}
+
+		report := map[string]interface{}{
+			"report_type":       reportType,
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Malaysia's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *MalaysiaModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Malaysia module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Malaysia
+func (m *MalaysiaModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	myr := money.MustGetCurrency("MYR")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(myr, 5000000), // 50,000 MYR per day
+		"monthly": money.NewFromMinorInt64(myr, 50000000), // 500,000 MYR per month
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Malaysia.
+func (m *MalaysiaModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("MYR"), 300000))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Malaysia
+func (m *MalaysiaModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// In Malaysia, capital gains from cryptocurrency are subject to income tax
+	// if the activity is deemed to be trading in nature
+	// For simplicity, we'll assume a flat rate of 24% (corporate tax rate)
+	taxRate := big.NewRat(24, 100)
+
+	return tx.Amount.Mul(taxRate), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Malaysia
+func (m *MalaysiaModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on Securities Commission Malaysia's approved digital assets
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"XRP",  // Ripple
+		"BCH",  // Bitcoin Cash
+		"LTC",  // Litecoin
+		"BNB",  // Binance Coin
+		"USDT", // Tether
+	}
+}
+
+// malaysiaStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var malaysiaStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for Malaysia.
+// Securities Commission Malaysia's approved digital asset list doesn't
+// itself tier assets by risk, so this only distinguishes stablecoins
+// from other supported assets.
+func (m *MalaysiaModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if malaysiaStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for Malaysia
+func (m *MalaysiaModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *MalaysiaModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Malaysia
+func (m *MalaysiaModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Malaysia
+func (m *MalaysiaModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	myr := money.MustGetCurrency("MYR")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"National ID Number (MyKad)",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+		},
+		"business": []string{
+			"Business Name",
+			"Business Registration Number",
+			"Business Address",
+			"Director Information",
+			"Shareholder Information",
+			"Source of Funds",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(myr, 300000), // MYR, threshold for enhanced due diligence
+	}
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *MalaysiaModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
@@ -0,0 +1,314 @@
+package philippines
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// PhilippinesModule implements country-specific regulatory compliance
+// for the Philippines
+type PhilippinesModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+
+	// Philippines-specific fields
+	VASPLicensed bool // Whether the merchant holds a BSP Virtual Asset Service Provider license
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewPhilippinesModule creates a new instance of PhilippinesModule
+func NewPhilippinesModule() *PhilippinesModule {
+	return &PhilippinesModule{
+		CountryCode: "PH",
+		CountryName: "Philippines",
+		Regulators: []string{
+			"Bangko Sentral ng Pilipinas (BSP)",
+		},
+		VASPLicensed: false, // Default to false, should be set based on merchant license status
+	}
+}
+
+func init() {
+	compliance.Register("PH", func() compliance.Module { return NewPhilippinesModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Philippine regulations
+func (m *PhilippinesModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *PhilippinesModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant holds a BSP VASP license
+	if !m.VASPLicensed {
+		return false, errors.New("merchant does not hold a BSP Virtual Asset Service Provider license")
+	}
+
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in the Philippines")
+	}
+
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in the Philippines")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *PhilippinesModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Bangko Sentral ng Pilipinas (BSP)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// GenerateReports creates regulatory reports for the period and
+// pagination parameters described by query.
+func (m *PhilippinesModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Philippines module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("PHP")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		report := map[string]interface{}{
+			"report_type":       "BSP_VASP_Report",
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of the Philippines'
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *PhilippinesModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Philippines module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for the Philippines
+func (m *PhilippinesModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	// Based on BSP Circular 1108's VASP transaction monitoring thresholds
+	php := money.MustGetCurrency("PHP")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(php, 50000000), // 500,000 PHP per day
+		"monthly": money.NewFromMinorInt64(php, 500000000), // 5,000,000 PHP per month
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in the
+// Philippines.
+func (m *PhilippinesModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("PHP"), 5000000)) // PHP 50,000
+}
+
+// CalculateTax calculates applicable taxes for a transaction in the Philippines
+func (m *PhilippinesModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// The BIR has not issued specific cryptocurrency tax guidance beyond
+	// existing capital gains rules, so this returns 0 pending a BIR ruling.
+	return money.Zero(tx.Amount.Currency()), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies
+// supported in the Philippines
+func (m *PhilippinesModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on BSP-registered VASPs' commonly supported assets
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"USDT", // Tether
+		"BNB",  // Binance Coin
+	}
+}
+
+// philippinesStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var philippinesStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for the Philippines.
+// BSP regulates VASPs rather than tiering individual assets by risk,
+// so this only distinguishes stablecoins from other supported assets.
+func (m *PhilippinesModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if philippinesStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for the Philippines
+func (m *PhilippinesModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *PhilippinesModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in the Philippines
+func (m *PhilippinesModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for the Philippines
+func (m *PhilippinesModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	php := money.MustGetCurrency("PHP")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"Government-Issued ID",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Tax Identification Number (TIN)",
+		},
+		"business": []string{
+			"Business Name",
+			"SEC Registration Number",
+			"Tax Identification Number (TIN)",
+			"Business Address",
+			"Director Information",
+			"Beneficial Ownership Information",
+			"Source of Funds",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(php, 50000000), // PHP, threshold for enhanced due diligence
+	}
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *PhilippinesModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
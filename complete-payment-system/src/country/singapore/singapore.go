@@ -0,0 +1,359 @@
+package singapore
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// SingaporeModule implements country-specific regulatory compliance for Singapore
+type SingaporeModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+
+	// Singapore-specific fields
+	MASLicensed bool // Whether the merchant is licensed by Monetary Authority of Singapore
+	PSACompliant bool // Whether the merchant complies with Payment Services Act
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewSingaporeModule creates a new instance of SingaporeModule
+func NewSingaporeModule() *SingaporeModule {
+	return &SingaporeModule{
+		CountryCode: "SG",
+		CountryName: "Singapore",
+		Regulators: []string{
+			"Monetary Authority of Singapore (MAS)",
+		},
+		MASLicensed: false, // Default to false, should be set based on merchant registration
+		PSACompliant: false, // Default to false, should be set based on merchant compliance
+	}
+}
+
+func init() {
+	compliance.Register("SG", func() compliance.Module { return NewSingaporeModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Singapore regulations
+func (m *SingaporeModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *SingaporeModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is licensed by MAS (required for digital payment token services)
+	if !m.MASLicensed {
+		return false, errors.New("merchant is not licensed by Monetary Authority of Singapore")
+	}
+
+	// Check if the merchant complies with Payment Services Act
+	if !m.PSACompliant {
+		return false, errors.New("merchant does not comply with Payment Services Act requirements")
+	}
+
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Singapore")
+	}
+
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Singapore")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *SingaporeModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Monetary Authority of Singapore (MAS)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// GenerateReports creates regulatory reports for the specified time period
+func (m *SingaporeModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Singapore module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("SGD")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := []interface{}{
+		map[string]interface{}{
+			"report_type":       "MAS_Quarterly_Report",
+			"regulator":         "Monetary Authority of Singapore (MAS)",
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		},
+		map[string]interface{}{
+			"report_type":       "Travel_Rule_Compliance_Report",
+			"regulator":         "Monetary Authority of Singapore (MAS)",
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		},
+	}
+
+	if m.Events != nil {
+		for _, report := range reports {
+			reportType := report.(map[string]interface{})["report_type"].(string)
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   "Monetary Authority of Singapore (MAS)",
+				Outcome:     "generated",
+				Detail:      reportType,
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Singapore's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *SingaporeModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Singapore module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Singapore
+func (m *SingaporeModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	sgd := money.MustGetCurrency("SGD")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(sgd, 100000_00),  // 100,000 SGD per day
+		"monthly": money.NewFromMinorInt64(sgd, 1000000_00), // 1,000,000 SGD per month
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Singapore.
+func (m *SingaporeModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("SGD"), 500000))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Singapore
+func (m *SingaporeModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// In Singapore, cryptocurrency is treated as an intangible property
+	// and GST (Goods and Services Tax) applies at 8% (as of 2025)
+	taxRate := big.NewRat(8, 100)
+
+	return tx.Amount.Mul(taxRate), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Singapore
+func (m *SingaporeModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on MAS Payment Services Act regulated digital payment tokens
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"XRP",  // Ripple
+		"LTC",  // Litecoin
+		"BCH",  // Bitcoin Cash
+		"USDT", // Tether
+		"USDC", // USD Coin
+		"BNB",  // Binance Coin
+		"SOL",  // Solana
+		"ADA",  // Cardano
+	}
+}
+
+// singaporeStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var singaporeStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for Singapore. MAS's
+// Payment Services Act regulates digital payment tokens uniformly
+// rather than by risk tier, so this only distinguishes stablecoins
+// from other supported assets.
+func (m *SingaporeModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if singaporeStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for Singapore
+func (m *SingaporeModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *SingaporeModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Singapore
+func (m *SingaporeModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Singapore
+func (m *SingaporeModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	sgd := money.MustGetCurrency("SGD")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"NRIC or Passport Number",
+			"Date of Birth",
+			"Nationality",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Occupation",
+		},
+		"business": []string{
+			"Business Name",
+			"Unique Entity Number (UEN)",
+			"Business Address",
+			"Business Type",
+			"Director Information",
+			"Shareholder Information",
+			"Source of Funds",
+			"Business Activities",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(sgd, 5000_00), // SGD, threshold for enhanced due diligence
+	}
+}
+
+// GenerateTravelRuleData builds the IVMS 101 Travel Rule message for a
+// transaction, or nil if the amount is below Singapore's threshold.
+func (m *SingaporeModule) GenerateTravelRuleData(transaction interface{}, originator, beneficiary ivms101.Person, originatingVASP, beneficiaryVASP ivms101.VASP) (*ivms101.Message, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return nil, errors.New("invalid transaction type")
+	}
+
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return nil, err
+	} else if cmp <= 0 {
+		return nil, nil // Travel Rule does not apply
+	}
+
+	msg := travelrule.GenerateMessage(tx, originator, beneficiary, originatingVASP, beneficiaryVASP)
+	return &msg, nil
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *SingaporeModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
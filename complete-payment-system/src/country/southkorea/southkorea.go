@@ -0,0 +1,315 @@
+package southkorea
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// SouthKoreaModule implements country-specific regulatory compliance
+// for South Korea
+type SouthKoreaModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+
+	// South Korea-specific fields
+	FSCReported bool // Whether the merchant is reported to / registered with the Financial Services Commission as a VASP
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewSouthKoreaModule creates a new instance of SouthKoreaModule
+func NewSouthKoreaModule() *SouthKoreaModule {
+	return &SouthKoreaModule{
+		CountryCode: "KR",
+		CountryName: "South Korea",
+		Regulators: []string{
+			"Financial Services Commission (FSC)",
+		},
+		FSCReported: false, // Default to false, should be set based on merchant registration status
+	}
+}
+
+func init() {
+	compliance.Register("KR", func() compliance.Module { return NewSouthKoreaModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with South Korean regulations
+func (m *SouthKoreaModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *SouthKoreaModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is reported to / registered with the FSC
+	if !m.FSCReported {
+		return false, errors.New("merchant is not registered with the Financial Services Commission as a virtual asset service provider")
+	}
+
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in South Korea")
+	}
+
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in South Korea")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *SouthKoreaModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Financial Services Commission (FSC)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// GenerateReports creates regulatory reports for the period and
+// pagination parameters described by query.
+func (m *SouthKoreaModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for South Korea module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("KRW")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		report := map[string]interface{}{
+			"report_type":       "FSC_VASP_Report",
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of South Korea's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *SouthKoreaModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for South Korea module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for South Korea
+func (m *SouthKoreaModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	krw := money.MustGetCurrency("KRW")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(krw, 20000000),  // 20,000,000 KRW per day
+		"monthly": money.NewFromMinorInt64(krw, 200000000), // 200,000,000 KRW per month
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in South
+// Korea, per the Act on Reporting and Using Specified Financial
+// Transaction Information.
+func (m *SouthKoreaModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("KRW"), 1000000)) // KRW 1,000,000
+}
+
+// CalculateTax calculates applicable taxes for a transaction in South Korea
+func (m *SouthKoreaModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// South Korea's 20% tax on crypto gains above an annual KRW 2.5M
+	// exemption has been repeatedly postponed by the National Assembly;
+	// this placeholder returns 0 pending the rule actually taking effect.
+	return money.Zero(tx.Amount.Currency()), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in South Korea
+func (m *SouthKoreaModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on assets commonly listed by FSC-reported exchanges
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"XRP",  // Ripple
+		"USDT", // Tether
+		"BNB",  // Binance Coin
+	}
+}
+
+// southKoreaStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var southKoreaStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for South Korea. The
+// FSC's VASP reporting regime doesn't tier individual assets by risk,
+// so this only distinguishes stablecoins from other supported assets.
+func (m *SouthKoreaModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if southKoreaStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for South Korea
+func (m *SouthKoreaModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *SouthKoreaModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in South Korea
+func (m *SouthKoreaModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for South Korea
+func (m *SouthKoreaModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	krw := money.MustGetCurrency("KRW")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"Resident Registration Number",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Real-Name Verified Bank Account",
+		},
+		"business": []string{
+			"Business Name",
+			"Corporate Registration Number",
+			"Business Address",
+			"Representative Information",
+			"Beneficial Ownership Information",
+			"Source of Funds",
+			"Real-Name Verified Corporate Bank Account",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(krw, 10000000), // KRW, threshold for enhanced due diligence
+	}
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *SouthKoreaModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
@@ -1,211 +0,0 @@
-package thailand
-
-import (
-	"errors"
-	"time"
-)
-
-// Transaction represents a cryptocurrency payment transaction
-type Transaction struct {
-	ID               string
-	Amount           float64
-	Currency         string
-	CryptoCurrency   string
-	SourceAddress    string
-	DestinationAddress string
-	Status           string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	CompletedAt      time.Time
-	MerchantID       string
-	CustomerID       string
-	CountryCode      string
-}
-
-// ThailandModule implements country-specific regulatory compliance for Thailand
-type ThailandModule struct {
-	CountryCode string
-	CountryName string
-	Regulators  []string
-	
-	// Thailand-specific fields
-	SECLicensed bool // Whether the merchant is licensed by Securities and Exchange Commission
-	BOTCompliant bool // Whether the merchant complies with Bank of Thailand regulations
-}
-
-// NewThailandModule creates a new instance of ThailandModule
-func NewThailandModule() *ThailandModule {
-	return &ThailandModule{
-		CountryCode: "TH",
-		CountryName: "Thailand",
-		Regulators: []string{
-			"Securities and Exchange Commission (SEC)",
-			"Bank of Thailand (BOT)",
-		},
-		SECLicensed: false, // Default to false, should be set based on merchant registration
-		BOTCompliant: false, // Default to false, should be set based on merchant compliance
-	}
-}
-
-// ValidateTransaction checks if a transaction complies with Thai regulations
-func (m *ThailandModule) ValidateTransaction(transaction interface{}) (bool, error) {
-	tx, ok := transaction.(*Transaction)
-	if !ok {
-		return false, errors.New("invalid transaction type")
-	}
-	
-	// Check if the merchant is licensed by SEC
-	if !m.SECLicensed {
-		return false, errors.New("merchant is not licensed by Securities and Exchange Commission Thailand")
-	}
-	
-	// Check if the merchant complies with BOT regulations
-	if !m.BOTCompliant {
-		return false, errors.New("merchant does not comply with Bank of Thailand regulations")
-	}
-	
-	// Check transaction limits
-	limits := m.GetTransactionLimits()
-	if tx.Amount > limits["daily"] {
-		return false, errors.New("transaction exceeds daily limit")
-	}
-	
-	// Check if cryptocurrency is supported
-	supported := m.GetSupportedCryptocurrencies()
-	cryptoSupported := false
-	for _, crypto := range supported {
-		if crypto == tx.CryptoCurrency {
-			cryptoSupported = true
-			break
-		}
-	}
-	
-	if !cryptoSupported {
-		return false, errors.New("cryptocurrency not supported in Thailand")
-	}
-	
-	// All checks passed
-	return true, nil
-}
-
-// GenerateReports creates regulatory reports for the specified time period
-func (m *ThailandModule) GenerateReports(startTime, endTime interface{}) ([]interface{}, error) {
-	// In a real implementation, we would:
-	// 1. Query transactions for the specified time period
-	// 2. Format the data according to SEC and BOT requirements
-	// 3. Generate the required reports
-	
-	// For now, we'll return a placeholder
-	return []interface{}{
-		map[string]string{
-			"report_type": "SEC_Monthly_Report",
-			"period": "2025-03",
-			"status": "generated",
-		},
-		map[string]string{
-			"report_type": "BOT_Quarterly_Report",
-			"period": "2025-Q1",
-			"status": "generated",
-		},
-	}, nil
-}
-
-// GetTransactionLimits returns the transaction limits for Thailand
-func (m *ThailandModule) GetTransactionLimits() map[string]float64 {
-	return map[string]float64{
-		"daily":   1000000.0,  // 1,000,000 THB per day
-		"monthly": 10000000.0, // 10,000,000 THB per month
-	}
-}
-
-// CalculateTax calculates applicable taxes for a transaction in Thailand
-func (m *ThailandModule) CalculateTax(transaction interface{}) (float64, error) {
-	tx, ok := transaction.(*Transaction)
-	if !ok {
-		return 0, errors.New("invalid transaction type")
-	}
-	
-	// In Thailand, cryptocurrency profits are subject to 15% capital gains tax
-	taxRate := 0.15
-	
-	return tx.Amount * taxRate, nil
-}
-
-// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Thailand
-func (m *ThailandModule) GetSupportedCryptocurrencies() []string {
-	// Based on SEC Thailand's approved digital assets
-	return []string{
-		"BTC",  // Bitcoin
-		"ETH",  // Ethereum
-		"XRP",  // Ripple
-		"USDT", // Tether
-		"BNB",  // Binance Coin
-		"ADA",  // Cardano
-		"DOT",  // Polkadot
-		"SOL",  // Solana
-	}
-}
-
-// GetCountryCode returns the ISO country code for Thailand
-func (m *ThailandModule) GetCountryCode() string {
-	return m.CountryCode
-}
-
-// GetCountryName returns the country name
-func (m *ThailandModule) GetCountryName() string {
-	return m.CountryName
-}
-
-// GetRegulators returns the list of regulatory authorities in Thailand
-func (m *ThailandModule) GetRegulators() []string {
-	return m.Regulators
-}
-
-// GetKYCRequirements returns the KYC requirements for Thailand
-func (m *ThailandModule) GetKYCRequirements() map[string]interface{} {
-	return map[string]interface{}{
-		"individual": []string{
-			"Full Name",
-			"National ID Card or Passport",
-			"Date of Birth",
-			"Residential Address",
-			"Contact Information",
-			"Source of Funds",
-			"Occupation",
-			"Facial Verification",
-		},
-		"business": []string{
-			"Business Name",
-			"Business Registration Number",
-			"Tax ID",
-			"Business Address",
-			"Director Information",
-			"Shareholder Information",
-			"Source of Funds",
-			"Business Activities",
-		},
-		"transaction_threshold": 100000.0, // THB, threshold for enhanced due diligence
-	}
-}
-
-// CheckPaymentRestriction checks if the transaction is for payment purposes
-func (m *ThailandModule) CheckPaymentRestriction(purpose string) (bool, error) {
-	// In Thailand, using cryptocurrency as a means of payment is restricted
-	// Merchants should not accept crypto for direct payment for goods and services
-	
-	paymentPurposes := []string{
-		"payment",
-		"purchase",
-		"goods",
-		"services",
-		"bill",
-	}
-	
-	for _, p := range paymentPurposes {
-		if purpose == p {
-			return false, errors.New("using cryptocurrency as a means of payment is restricted in Thailand")
-		}
-	}
-	
-	return true, nil
-}
@@ -0,0 +1,360 @@
+package thailand
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// ThailandModule implements country-specific regulatory compliance for Thailand
+type ThailandModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+	
+	// Thailand-specific fields
+	SECLicensed bool // Whether the merchant is licensed by Securities and Exchange Commission
+	BOTCompliant bool // Whether the merchant complies with Bank of Thailand regulations
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewThailandModule creates a new instance of ThailandModule
+func NewThailandModule() *ThailandModule {
+	return &ThailandModule{
+		CountryCode: "TH",
+		CountryName: "Thailand",
+		Regulators: []string{
+			"Securities and Exchange Commission (SEC)",
+			"Bank of Thailand (BOT)",
+		},
+		SECLicensed: false, // Default to false, should be set based on merchant registration
+		BOTCompliant: false, // Default to false, should be set based on merchant compliance
+	}
+}
+
+func init() {
+	compliance.Register("TH", func() compliance.Module { return NewThailandModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Thai regulations
+func (m *ThailandModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *ThailandModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is licensed by SEC
+	if !m.SECLicensed {
+		return false, errors.New("merchant is not licensed by Securities and Exchange Commission Thailand")
+	}
+	
+	// Check if the merchant complies with BOT regulations
+	if !m.BOTCompliant {
+		return false, errors.New("merchant does not comply with Bank of Thailand regulations")
+	}
+	
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+	
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+	
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Thailand")
+	}
+	
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Thailand")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *ThailandModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "Securities and Exchange Commission (SEC)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// thailandReportTypes maps each Thai regulator to the report type
+// GenerateReports submits to it.
+var thailandReportTypes = map[string]string{
+	"Securities and Exchange Commission (SEC)": "SEC_Monthly_Report",
+	"Bank of Thailand (BOT)":                   "BOT_Quarterly_Report",
+}
+
+// GenerateReports creates regulatory reports for the specified time period
+func (m *ThailandModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Thailand module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("THB")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		reportType, ok := thailandReportTypes[regulator]
+		if !ok {
+			reportType = "Regulatory_Report"
+		}
+
+		report := map[string]interface{}{
+			"report_type":       reportType,
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Thailand's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *ThailandModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Thailand module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Thailand
+func (m *ThailandModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	thb := money.MustGetCurrency("THB")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(thb, 100000000),  // 1,000,000 THB per day
+		"monthly": money.NewFromMinorInt64(thb, 1000000000), // 10,000,000 THB per month
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Thailand.
+func (m *ThailandModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("THB"), 5000000))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Thailand
+func (m *ThailandModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// In Thailand, cryptocurrency profits are subject to 15% capital gains tax
+	taxRate := big.NewRat(15, 100)
+
+	return tx.Amount.Mul(taxRate), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Thailand
+func (m *ThailandModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on SEC Thailand's approved digital assets
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"XRP",  // Ripple
+		"USDT", // Tether
+		"BNB",  // Binance Coin
+		"ADA",  // Cardano
+		"DOT",  // Polkadot
+		"SOL",  // Solana
+	}
+}
+
+// thailandStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var thailandStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for Thailand. SEC
+// Thailand's approved digital asset list doesn't itself tier assets by
+// risk, so this only distinguishes stablecoins from other supported
+// assets.
+func (m *ThailandModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if thailandStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for Thailand
+func (m *ThailandModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *ThailandModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Thailand
+func (m *ThailandModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Thailand
+func (m *ThailandModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	thb := money.MustGetCurrency("THB")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"National ID Card or Passport",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Occupation",
+			"Facial Verification",
+		},
+		"business": []string{
+			"Business Name",
+			"Business Registration Number",
+			"Tax ID",
+			"Business Address",
+			"Director Information",
+			"Shareholder Information",
+			"Source of Funds",
+			"Business Activities",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(thb, 10000000), // THB, threshold for enhanced due diligence
+	}
+}
+
+// CheckPaymentRestriction checks if the transaction is for payment purposes
+func (m *ThailandModule) CheckPaymentRestriction(purpose string) (bool, error) {
+	// In Thailand, using cryptocurrency as a means of payment is restricted
+	// Merchants should not accept crypto for direct payment for goods and services
+	
+	paymentPurposes := []string{
+		"payment",
+		"purchase",
+		"goods",
+		"services",
+		"bill",
+	}
+	
+	for _, p := range paymentPurposes {
+		if purpose == p {
+			return false, errors.New("using cryptocurrency as a means of payment is restricted in Thailand")
+		}
+	}
+	
+	return true, nil
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *ThailandModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
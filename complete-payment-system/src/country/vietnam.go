@@ -1,198 +0,0 @@
-package vietnam
-
-import (
-	"errors"
-	"time"
-)
-
-// Transaction represents a cryptocurrency payment transaction
-type Transaction struct {
-	ID               string
-	Amount           float64
-	Currency         string
-	CryptoCurrency   string
-	SourceAddress    string
-	DestinationAddress string
-	Status           string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	CompletedAt      time.Time
-	MerchantID       string
-	CustomerID       string
-	CountryCode      string
-}
-
-// VietnamModule implements country-specific regulatory compliance for Vietnam
-type VietnamModule struct {
-	CountryCode string
-	CountryName string
-	Regulators  []string
-	
-	// Vietnam-specific fields
-	SBVRegistered bool // Whether the merchant is registered with State Bank of Vietnam
-	MOFCompliant bool  // Whether the merchant complies with Ministry of Finance regulations
-}
-
-// NewVietnamModule creates a new instance of VietnamModule
-func NewVietnamModule() *VietnamModule {
-	return &VietnamModule{
-		CountryCode: "VN",
-		CountryName: "Vietnam",
-		Regulators: []string{
-			"State Bank of Vietnam (SBV)",
-			"Ministry of Finance (MOF)",
-		},
-		SBVRegistered: false, // Default to false, should be set based on merchant registration
-		MOFCompliant: false,  // Default to false, should be set based on merchant compliance
-	}
-}
-
-// ValidateTransaction checks if a transaction complies with Vietnamese regulations
-func (m *VietnamModule) ValidateTransaction(transaction interface{}) (bool, error) {
-	tx, ok := transaction.(*Transaction)
-	if !ok {
-		return false, errors.New("invalid transaction type")
-	}
-	
-	// Check if the merchant is registered with SBV
-	if !m.SBVRegistered {
-		return false, errors.New("merchant is not registered with State Bank of Vietnam")
-	}
-	
-	// Check if the merchant complies with MOF regulations
-	if !m.MOFCompliant {
-		return false, errors.New("merchant does not comply with Ministry of Finance regulations")
-	}
-	
-	// Check transaction limits
-	limits := m.GetTransactionLimits()
-	if tx.Amount > limits["daily"] {
-		return false, errors.New("transaction exceeds daily limit")
-	}
-	
-	// Check if cryptocurrency is supported
-	supported := m.GetSupportedCryptocurrencies()
-	cryptoSupported := false
-	for _, crypto := range supported {
-		if crypto == tx.CryptoCurrency {
-			cryptoSupported = true
-			break
-		}
-	}
-	
-	if !cryptoSupported {
-		return false, errors.New("cryptocurrency not supported in Vietnam")
-	}
-	
-	// All checks passed
-	return true, nil
-}
-
-// GenerateReports creates regulatory reports for the specified time period
-func (m *VietnamModule) GenerateReports(startTime, endTime interface{}) ([]interface{}, error) {
-	// In a real implementation, we would:
-	// 1. Query transactions for the specified time period
-	// 2. Format the data according to SBV and MOF requirements
-	// 3. Generate the required reports
-	
-	// For now, we'll return a placeholder
-	return []interface{}{
-		map[string]string{
-			"report_type": "SBV_Monthly_Report",
-			"period": "2025-03",
-			"status": "generated",
-		},
-		map[string]string{
-			"report_type": "MOF_Quarterly_Report",
-			"period": "2025-Q1",
-			"status": "generated",
-		},
-	}, nil
-}
-
-// GetTransactionLimits returns the transaction limits for Vietnam
-func (m *VietnamModule) GetTransactionLimits() map[string]float64 {
-	return map[string]float64{
-		"daily":   500000000.0,  // 500,000,000 VND per day (approx. $20,000 USD)
-		"monthly": 5000000000.0, // 5,000,000,000 VND per month (approx. $200,000 USD)
-	}
-}
-
-// CalculateTax calculates applicable taxes for a transaction in Vietnam
-func (m *VietnamModule) CalculateTax(transaction interface{}) (float64, error) {
-	tx, ok := transaction.(*Transaction)
-	if !ok {
-		return 0, errors.New("invalid transaction type")
-	}
-	
-	// In Vietnam, cryptocurrency transactions may be subject to personal income tax
-	// at the rate of 20% for capital gains
-	taxRate := 0.20
-	
-	return tx.Amount * taxRate, nil
-}
-
-// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Vietnam
-func (m *VietnamModule) GetSupportedCryptocurrencies() []string {
-	// Based on common cryptocurrencies, as Vietnam has no official list
-	return []string{
-		"BTC",  // Bitcoin
-		"ETH",  // Ethereum
-		"USDT", // Tether
-		"BNB",  // Binance Coin
-	}
-}
-
-// GetCountryCode returns the ISO country code for Vietnam
-func (m *VietnamModule) GetCountryCode() string {
-	return m.CountryCode
-}
-
-// GetCountryName returns the country name
-func (m *VietnamModule) GetCountryName() string {
-	return m.CountryName
-}
-
-// GetRegulators returns the list of regulatory authorities in Vietnam
-func (m *VietnamModule) GetRegulators() []string {
-	return m.Regulators
-}
-
-// GetKYCRequirements returns the KYC requirements for Vietnam
-func (m *VietnamModule) GetKYCRequirements() map[string]interface{} {
-	return map[string]interface{}{
-		"individual": []string{
-			"Full Name",
-			"National ID or Passport",
-			"Date of Birth",
-			"Residential Address",
-			"Contact Information",
-			"Source of Funds",
-			"Occupation",
-		},
-		"business": []string{
-			"Business Name",
-			"Business Registration Number",
-			"Tax Identification Number",
-			"Business Address",
-			"Director Information",
-			"Shareholder Information",
-			"Source of Funds",
-			"Business Activities",
-		},
-		"transaction_threshold": 50000000.0, // VND, threshold for enhanced due diligence (approx. $2,000 USD)
-	}
-}
-
-// CheckRegulatoryStatus checks if the transaction complies with the upcoming regulatory framework
-func (m *VietnamModule) CheckRegulatoryStatus() (string, error) {
-	// Vietnam is expected to implement a new regulatory framework in May 2025
-	currentTime := time.Now()
-	regulatoryDeadline := time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
-	
-	if currentTime.After(regulatoryDeadline) {
-		return "New regulatory framework in effect. Please ensure compliance with updated SBV regulations.", nil
-	}
-	
-	return "Operating under transitional regulatory framework. New regulations expected in May 2025.", nil
-}
@@ -0,0 +1,365 @@
+package vietnam
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/storage"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/travelrule"
+)
+
+// VietnamModule implements country-specific regulatory compliance for Vietnam
+type VietnamModule struct {
+	CountryCode string
+	CountryName string
+	Regulators  []string
+	
+	// Vietnam-specific fields
+	SBVRegistered bool // Whether the merchant is registered with State Bank of Vietnam
+	MOFCompliant bool  // Whether the merchant complies with Ministry of Finance regulations
+
+	// Store and Events are optional; when set, every ValidateTransaction
+	// outcome is recorded and GenerateReports queries real transaction
+	// data instead of returning placeholders.
+	Store  storage.TransactionStore
+	Events storage.ComplianceEventStore
+
+	// Rules, when set (CountryCode non-empty), overrides the
+	// hardcoded defaults below with regulator data loaded via
+	// compliance.LoadRules, so updates don't require a code change.
+	Rules compliance.Rules
+}
+
+// NewVietnamModule creates a new instance of VietnamModule
+func NewVietnamModule() *VietnamModule {
+	return &VietnamModule{
+		CountryCode: "VN",
+		CountryName: "Vietnam",
+		Regulators: []string{
+			"State Bank of Vietnam (SBV)",
+			"Ministry of Finance (MOF)",
+		},
+		SBVRegistered: false, // Default to false, should be set based on merchant registration
+		MOFCompliant: false,  // Default to false, should be set based on merchant compliance
+	}
+}
+
+func init() {
+	compliance.Register("VN", func() compliance.Module { return NewVietnamModule() })
+}
+
+// ValidateTransaction checks if a transaction complies with Vietnamese regulations
+func (m *VietnamModule) ValidateTransaction(transaction interface{}) (bool, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return false, errors.New("invalid transaction type")
+	}
+
+	valid, err := m.validateTransaction(tx)
+	m.recordValidation(tx, valid, err)
+	return valid, err
+}
+
+func (m *VietnamModule) validateTransaction(tx *compliance.Transaction) (bool, error) {
+	// Check if the merchant is registered with SBV
+	if !m.SBVRegistered {
+		return false, errors.New("merchant is not registered with State Bank of Vietnam")
+	}
+	
+	// Check if the merchant complies with MOF regulations
+	if !m.MOFCompliant {
+		return false, errors.New("merchant does not comply with Ministry of Finance regulations")
+	}
+	
+	// Check transaction limits
+	limits := m.GetTransactionLimits()
+	if cmp, err := tx.Amount.Cmp(limits["daily"]); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		return false, errors.New("transaction exceeds daily limit")
+	}
+	
+	// Check if cryptocurrency is supported
+	supported := m.GetSupportedCryptocurrencies()
+	cryptoSupported := false
+	for _, crypto := range supported {
+		if crypto == tx.CryptoCurrency {
+			cryptoSupported = true
+			break
+		}
+	}
+	
+	if !cryptoSupported {
+		return false, errors.New("cryptocurrency not supported in Vietnam")
+	}
+	
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return false, err
+	} else if cmp > 0 {
+		if tx.TravelRule == nil {
+			return false, errors.New("travel rule originator/beneficiary data required for transactions above threshold in Vietnam")
+		}
+		if err := travelrule.ValidateMessage(*tx.TravelRule); err != nil {
+			return false, err
+		}
+	}
+
+	// All checks passed
+	return true, nil
+}
+
+// recordValidation persists the outcome of a ValidateTransaction call as
+// a compliance event, when an event store is configured.
+func (m *VietnamModule) recordValidation(tx *compliance.Transaction, valid bool, validationErr error) {
+	if m.Events == nil {
+		return
+	}
+
+	outcome := "approved"
+	detail := ""
+	if validationErr != nil {
+		outcome = "rejected"
+		detail = validationErr.Error()
+	} else if !valid {
+		outcome = "rejected"
+	}
+
+	_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+		CountryCode:   m.CountryCode,
+		MerchantID:    tx.MerchantID,
+		TransactionID: tx.ID,
+		EventType:     "validate_transaction",
+		Regulator:     "State Bank of Vietnam (SBV)",
+		Outcome:       outcome,
+		Detail:        detail,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// vietnamReportTypes maps each Vietnamese regulator to the report type
+// GenerateReports submits to it.
+var vietnamReportTypes = map[string]string{
+	"State Bank of Vietnam (SBV)": "SBV_Monthly_Report",
+	"Ministry of Finance (MOF)":   "MOF_Quarterly_Report",
+}
+
+// GenerateReports creates regulatory reports for the specified time period
+func (m *VietnamModule) GenerateReports(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Vietnam module")
+	}
+
+	filter := storage.Filter{CountryCode: m.CountryCode, From: query.Start, To: query.End, Order: query.Order, Limit: 200}
+	count, total, err := storage.AggregateTransactions(context.Background(), m.Store, filter, money.Zero(money.MustGetCurrency("VND")))
+	if err != nil {
+		return storage.ReportPage{}, err
+	}
+
+	reports := make([]interface{}, 0, len(m.Regulators))
+	for _, regulator := range m.Regulators {
+		reportType, ok := vietnamReportTypes[regulator]
+		if !ok {
+			reportType = "Regulatory_Report"
+		}
+
+		report := map[string]interface{}{
+			"report_type":       reportType,
+			"regulator":         regulator,
+			"country_code":      m.CountryCode,
+			"period_start":      query.Start.Format(time.RFC3339),
+			"period_end":        query.End.Format(time.RFC3339),
+			"transaction_count": count,
+			"transaction_total": total,
+			"status":            "generated",
+		}
+		reports = append(reports, report)
+
+		if m.Events != nil {
+			_ = m.Events.Record(context.Background(), storage.ComplianceEvent{
+				CountryCode: m.CountryCode,
+				EventType:   "report_generated",
+				Regulator:   regulator,
+				Outcome:     "generated",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return storage.ReportPage{Items: reports, HasMore: false}, nil
+}
+
+// ListTransactions returns a cursor-paginated page of Vietnam's
+// transactions, long-polling when query.TimeoutMs is set.
+func (m *VietnamModule) ListTransactions(query storage.ReportQuery) (storage.ReportPage, error) {
+	if m.Store == nil {
+		return storage.ReportPage{}, errors.New("no transaction store configured for Vietnam module")
+	}
+	return storage.Paginate(context.Background(), m.Store, m.CountryCode, query)
+}
+
+// GetTransactionLimits returns the transaction limits for Vietnam
+func (m *VietnamModule) GetTransactionLimits() map[string]money.Amount {
+	if m.Rules.CountryCode != "" {
+		if limits, err := m.Rules.Limits(); err == nil {
+			return limits
+		}
+	}
+
+	vnd := money.MustGetCurrency("VND")
+	return map[string]money.Amount{
+		"daily":   money.NewFromMinorInt64(vnd, 500000000),  // 500,000,000 VND per day (approx. $20,000 USD)
+		"monthly": money.NewFromMinorInt64(vnd, 5000000000), // 5,000,000,000 VND per month (approx. $200,000 USD)
+	}
+}
+
+// GetTravelRuleThreshold returns the transaction amount above which
+// FATF Travel Rule originator/beneficiary data is required in Vietnam.
+func (m *VietnamModule) GetTravelRuleThreshold() money.Amount {
+	return travelrule.ThresholdFor(m.CountryCode, money.NewFromMinorInt64(money.MustGetCurrency("VND"), 50000000))
+}
+
+// CalculateTax calculates applicable taxes for a transaction in Vietnam
+func (m *VietnamModule) CalculateTax(transaction interface{}) (money.Amount, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return money.Amount{}, errors.New("invalid transaction type")
+	}
+
+	if m.Rules.CountryCode != "" && m.Rules.TaxRatePercent != "" {
+		rate, err := m.Rules.TaxRate()
+		if err != nil {
+			return money.Amount{}, err
+		}
+		return tx.Amount.Mul(rate), nil
+	}
+
+	// In Vietnam, cryptocurrency transactions may be subject to personal income tax
+	// at the rate of 20% for capital gains
+	taxRate := big.NewRat(20, 100)
+
+	return tx.Amount.Mul(taxRate), nil
+}
+
+// GetSupportedCryptocurrencies returns the list of cryptocurrencies supported in Vietnam
+func (m *VietnamModule) GetSupportedCryptocurrencies() []string {
+	if m.Rules.CountryCode != "" && len(m.Rules.SupportedCryptocurrencies) > 0 {
+		return m.Rules.SupportedCryptocurrencies
+	}
+
+	// Based on common cryptocurrencies, as Vietnam has no official list
+	return []string{
+		"BTC",  // Bitcoin
+		"ETH",  // Ethereum
+		"USDT", // Tether
+		"BNB",  // Binance Coin
+	}
+}
+
+// vietnamStablecoins lists the supported cryptocurrencies
+// ClassifyCryptoAsset treats as fiat-backed stablecoins.
+var vietnamStablecoins = map[string]bool{"USDT": true, "USDC": true, "BUSD": true, "DAI": true}
+
+// ClassifyCryptoAsset classifies a cryptocurrency for Vietnam, which
+// has no official risk-tier taxonomy, so this only distinguishes
+// stablecoins from other supported assets.
+func (m *VietnamModule) ClassifyCryptoAsset(cryptoCurrency string) (string, error) {
+	for _, crypto := range m.GetSupportedCryptocurrencies() {
+		if crypto != cryptoCurrency {
+			continue
+		}
+		if vietnamStablecoins[cryptoCurrency] {
+			return "Stablecoin", nil
+		}
+		return "Cryptocurrency", nil
+	}
+	return "", errors.New("unsupported cryptocurrency")
+}
+
+// GetCountryCode returns the ISO country code for Vietnam
+func (m *VietnamModule) GetCountryCode() string {
+	return m.CountryCode
+}
+
+// GetCountryName returns the country name
+func (m *VietnamModule) GetCountryName() string {
+	return m.CountryName
+}
+
+// GetRegulators returns the list of regulatory authorities in Vietnam
+func (m *VietnamModule) GetRegulators() []string {
+	return m.Regulators
+}
+
+// GetKYCRequirements returns the KYC requirements for Vietnam
+func (m *VietnamModule) GetKYCRequirements() map[string]interface{} {
+	if m.Rules.CountryCode != "" && len(m.Rules.KYCRequirements) > 0 {
+		return m.Rules.KYCRequirements
+	}
+
+	vnd := money.MustGetCurrency("VND")
+	return map[string]interface{}{
+		"individual": []string{
+			"Full Name",
+			"National ID or Passport",
+			"Date of Birth",
+			"Residential Address",
+			"Contact Information",
+			"Source of Funds",
+			"Occupation",
+		},
+		"business": []string{
+			"Business Name",
+			"Business Registration Number",
+			"Tax Identification Number",
+			"Business Address",
+			"Director Information",
+			"Shareholder Information",
+			"Source of Funds",
+			"Business Activities",
+		},
+		"transaction_threshold": money.NewFromMinorInt64(vnd, 50000000), // VND, threshold for enhanced due diligence (approx. $2,000 USD)
+	}
+}
+
+// CheckRegulatoryStatus checks if the transaction complies with the upcoming regulatory framework
+func (m *VietnamModule) CheckRegulatoryStatus() (string, error) {
+	// Vietnam is expected to implement a new regulatory framework in May 2025
+	currentTime := time.Now()
+	regulatoryDeadline := time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
+	
+	if currentTime.After(regulatoryDeadline) {
+		return "New regulatory framework in effect. Please ensure compliance with updated SBV regulations.", nil
+	}
+	
+	return "Operating under transitional regulatory framework. New regulations expected in May 2025.", nil
+}
+
+// GenerateTravelRuleData builds the IVMS 101 Travel Rule message for a
+// transaction, or nil if the amount is below Vietnam's threshold.
+func (m *VietnamModule) GenerateTravelRuleData(transaction interface{}, originator, beneficiary ivms101.Person, originatingVASP, beneficiaryVASP ivms101.VASP) (*ivms101.Message, error) {
+	tx, ok := transaction.(*compliance.Transaction)
+	if !ok {
+		return nil, errors.New("invalid transaction type")
+	}
+
+	if cmp, err := tx.Amount.Cmp(m.GetTravelRuleThreshold()); err != nil {
+		return nil, err
+	} else if cmp <= 0 {
+		return nil, nil // Travel Rule does not apply
+	}
+
+	msg := travelrule.GenerateMessage(tx, originator, beneficiary, originatingVASP, beneficiaryVASP)
+	return &msg, nil
+}
+
+// SetRules implements compliance.RulesConfigurable, letting the
+// registry apply regulator data loaded via compliance.LoadRules or
+// compliance.EmbeddedRules without constructing a new module.
+func (m *VietnamModule) SetRules(r compliance.Rules) {
+	m.Rules = r
+}
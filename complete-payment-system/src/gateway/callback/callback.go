@@ -0,0 +1,52 @@
+// Package callback decrypts, verifies, and dispatches the
+// AES-256-GCM-encrypted callback notifications regional gateways like
+// WeChat Pay, Alipay, PayNow, and VNPay deliver: a merchant's webhook
+// endpoint receives a JSON envelope whose "resource" field is
+// ciphertext, not a usable payload, so every integration otherwise
+// ends up hand-rolling its own decrypt-then-parse glue. Handler does
+// that once, behind framework-neutral callback registration.
+package callback
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+)
+
+// algorithmAESGCM is the only Resource.Algorithm value Handler
+// accepts; anything else is rejected rather than assumed compatible.
+const algorithmAESGCM = "AEAD_AES_256_GCM"
+
+// Decryptor decrypts an AES-256-GCM resource envelope using the
+// merchant's API key, authenticating associatedData alongside
+// ciphertext the same way the gateway encrypted it.
+type Decryptor interface {
+	Decrypt(ctx context.Context, key, nonce, associatedData, ciphertext []byte) ([]byte, error)
+}
+
+// AESGCMDecryptor is the standard Decryptor every gateway in this
+// package's scope uses: AES-256-GCM with the merchant's API v3 key.
+type AESGCMDecryptor struct{}
+
+// Decrypt implements Decryptor.
+func (AESGCMDecryptor) Decrypt(ctx context.Context, key, nonce, associatedData, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("callback: building AES cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("callback: building GCM: %w", err)
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("callback: nonce is %d bytes, want %d", len(nonce), gcm.NonceSize())
+	}
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("callback: decrypting resource: %w", err)
+	}
+	return plaintext, nil
+}
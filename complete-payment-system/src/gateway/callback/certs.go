@@ -0,0 +1,66 @@
+package callback
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+)
+
+// CertificateCache holds a gateway's signing certificates keyed by
+// serial number. Gateways rotate these periodically and publish the
+// new one before retiring the old, so Handler looks a callback's
+// CertSerial up here rather than assuming a single fixed key, the same
+// rotation shape webhook.Verifier uses for secrets.
+type CertificateCache struct {
+	mu    sync.RWMutex
+	certs map[string]*rsa.PublicKey
+}
+
+// NewCertificateCache creates an empty CertificateCache.
+func NewCertificateCache() *CertificateCache {
+	return &CertificateCache{certs: make(map[string]*rsa.PublicKey)}
+}
+
+// Put registers (or replaces) the public key for serial.
+func (c *CertificateCache) Put(serial string, key *rsa.PublicKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.certs[serial] = key
+}
+
+// Revoke removes serial, e.g. once a gateway's rotation grace period
+// for it has ended.
+func (c *CertificateCache) Revoke(serial string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.certs, serial)
+}
+
+// Get returns the public key registered for serial.
+func (c *CertificateCache) Get(serial string) (*rsa.PublicKey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	key, ok := c.certs[serial]
+	if !ok {
+		return nil, fmt.Errorf("callback: no certificate registered for serial %q", serial)
+	}
+	return key, nil
+}
+
+// VerifySignature checks that signature is a valid RSA-SHA256
+// (PKCS#1 v1.5) signature over message, made with the private key
+// matching the certificate registered under serial — the scheme
+// WeChat Pay, Alipay, and compatible gateways use to sign callbacks.
+func (c *CertificateCache) VerifySignature(serial string, message, signature []byte) error {
+	key, err := c.Get(serial)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(message)
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return fmt.Errorf("callback: signature verification failed for serial %q: %w", serial, err)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package callback
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewGinHandlerFunc adapts h to gin, dispatching every request to
+// eventType. Register one per callback route the merchant exposes
+// (e.g. router.POST("/callbacks/pay", NewGinHandlerFunc(h, EventPayNotify))).
+func NewGinHandlerFunc(h *Handler, eventType EventType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, Response{Code: "FAIL", Message: "could not read request body"})
+			return
+		}
+
+		resp, err := h.Handle(c.Request.Context(), eventType, body, c.GetHeader(headerSerial), c.GetHeader(headerSignature))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, Response{Code: "FAIL", Message: err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
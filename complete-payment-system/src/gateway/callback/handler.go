@@ -0,0 +1,167 @@
+package callback
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// TransactionNotifier is the subset of
+// notification.NotificationService.CreateTransactionNotification
+// Handler needs to tell a merchant/customer a callback arrived.
+// Defining it here instead of importing package notification keeps
+// this package independent of notification's storage/dispatch
+// internals, the same reasoning chain.TransactionNotifier follows.
+type TransactionNotifier interface {
+	CreateTransactionNotification(transactionID string, event string, recipient string, merchantID string) (string, error)
+}
+
+// PayNotifyHandlerFunc handles a decrypted, verified PayNotify
+// callback.
+type PayNotifyHandlerFunc func(ctx context.Context, payload PayNotifyPayload) error
+
+// RefundNotifyHandlerFunc handles a decrypted, verified RefundNotify
+// callback.
+type RefundNotifyHandlerFunc func(ctx context.Context, payload RefundNotifyPayload) error
+
+// Handler decrypts and dispatches gateway callbacks. It is framework
+// neutral — http.go and gin.go adapt it to net/http and gin without
+// duplicating the decrypt/verify/unmarshal logic.
+type Handler struct {
+	Decryptor Decryptor
+	Certs     *CertificateCache
+
+	// APIKey is the merchant's API v3 key used to decrypt a callback's
+	// Resource.
+	APIKey []byte
+
+	// Notifier, if set, receives a TransactionNotification for every
+	// successfully handled callback.
+	Notifier TransactionNotifier
+
+	// NotifyRecipient is who Notifier's CreateTransactionNotification
+	// is sent to; required if Notifier is set.
+	NotifyRecipient string
+
+	// OnPayNotify and OnRefundNotify must be idempotent: a gateway
+	// retries delivery of the same callback whenever Handle returns a
+	// non-success Response, including when that happens because
+	// Notifier failed after the handler already ran.
+	OnPayNotify    PayNotifyHandlerFunc
+	OnRefundNotify RefundNotifyHandlerFunc
+}
+
+// NewHandler creates a Handler using the standard AES-256-GCM
+// decryptor.
+func NewHandler(certs *CertificateCache, apiKey []byte) *Handler {
+	return &Handler{Decryptor: AESGCMDecryptor{}, Certs: certs, APIKey: apiKey}
+}
+
+// Handle verifies rawBody's signature, decrypts its Resource,
+// unmarshals it into the type registered for eventType, and invokes
+// that handler. It returns the Response to write back to the gateway.
+//
+// Signature verification is mandatory whenever h.Certs is set: a
+// request missing certSerial/signature is rejected outright rather
+// than treated as unverifiable-but-OK. Leave h.Certs nil only to
+// explicitly opt out (e.g. in a test harness driving Handle directly).
+func (h *Handler) Handle(ctx context.Context, eventType EventType, rawBody []byte, certSerial, signature string) (Response, error) {
+	if h.Certs != nil {
+		if certSerial == "" || signature == "" {
+			return Response{}, fmt.Errorf("callback: missing signature headers")
+		}
+		decodedSig, err := base64.StdEncoding.DecodeString(signature)
+		if err != nil {
+			return Response{}, fmt.Errorf("callback: malformed signature: %w", err)
+		}
+		if err := h.Certs.VerifySignature(certSerial, rawBody, decodedSig); err != nil {
+			return Response{}, err
+		}
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(rawBody, &envelope); err != nil {
+		return Response{}, fmt.Errorf("callback: parsing envelope: %w", err)
+	}
+	if envelope.EventType != "" && envelope.EventType != string(eventType) {
+		return Response{}, fmt.Errorf("callback: envelope event_type %q does not match registered %q", envelope.EventType, eventType)
+	}
+
+	plaintext, err := h.decrypt(ctx, envelope.Resource)
+	if err != nil {
+		return Response{}, err
+	}
+
+	transactionID := envelope.ID
+	switch eventType {
+	case EventPayNotify:
+		if h.OnPayNotify == nil {
+			return Response{}, fmt.Errorf("callback: no PayNotify handler registered")
+		}
+		var payload PayNotifyPayload
+		if err := json.Unmarshal(plaintext, &payload); err != nil {
+			return Response{}, fmt.Errorf("callback: parsing PayNotify payload: %w", err)
+		}
+		if err := h.OnPayNotify(ctx, payload); err != nil {
+			return Response{}, err
+		}
+		transactionID = payload.TransactionID
+
+	case EventRefundNotify:
+		if h.OnRefundNotify == nil {
+			return Response{}, fmt.Errorf("callback: no RefundNotify handler registered")
+		}
+		var payload RefundNotifyPayload
+		if err := json.Unmarshal(plaintext, &payload); err != nil {
+			return Response{}, fmt.Errorf("callback: parsing RefundNotify payload: %w", err)
+		}
+		if err := h.OnRefundNotify(ctx, payload); err != nil {
+			return Response{}, err
+		}
+		transactionID = payload.RefundID
+
+	default:
+		return Response{}, fmt.Errorf("callback: unsupported event type %q", eventType)
+	}
+
+	if h.Notifier != nil {
+		// This package has no concept of which merchant a callback
+		// belongs to (Handler is a decrypt/verify/dispatch layer, not a
+		// merchant-scoped one), so the resulting notification carries no
+		// merchant owner and can't later be replayed through
+		// NotificationService.ReplayWebhook's merchant-scoped check.
+		if _, err := h.Notifier.CreateTransactionNotification(transactionID, string(eventType), h.NotifyRecipient, ""); err != nil {
+			return Response{}, fmt.Errorf("callback: notifying %s of %s: %w", h.NotifyRecipient, eventType, err)
+		}
+	}
+
+	return Accepted, nil
+}
+
+// decrypt base64-decodes resource's fields and runs them through
+// h.Decryptor with h.APIKey.
+func (h *Handler) decrypt(ctx context.Context, resource Resource) ([]byte, error) {
+	if h.Decryptor == nil {
+		return nil, fmt.Errorf("callback: no decryptor configured")
+	}
+	if resource.Algorithm != algorithmAESGCM {
+		return nil, fmt.Errorf("callback: unsupported resource algorithm %q, want %q", resource.Algorithm, algorithmAESGCM)
+	}
+
+	nonce, err := base64.StdEncoding.DecodeString(resource.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("callback: malformed nonce: %w", err)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(resource.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("callback: malformed ciphertext: %w", err)
+	}
+	associatedData := []byte(resource.AssociatedData)
+
+	plaintext, err := h.Decryptor.Decrypt(ctx, h.APIKey, nonce, associatedData, ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("callback: decrypting resource: %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,42 @@
+package callback
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// gateway-specific header names used to pass the signature and
+// certificate serial alongside the request body. These match WeChat
+// Pay's headers; a gateway using different header names can still use
+// Handler.Handle directly instead of this adapter.
+const (
+	headerSignature = "Wechatpay-Signature"
+	headerSerial    = "Wechatpay-Serial"
+)
+
+// NewHTTPHandlerFunc adapts h to net/http, dispatching every request
+// to eventType. Register one per callback URL the merchant exposes
+// (e.g. /callbacks/pay, /callbacks/refund).
+func NewHTTPHandlerFunc(h *Handler, eventType EventType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeJSONResponse(w, http.StatusBadRequest, Response{Code: "FAIL", Message: "could not read request body"})
+			return
+		}
+
+		resp, err := h.Handle(r.Context(), eventType, body, r.Header.Get(headerSerial), r.Header.Get(headerSignature))
+		if err != nil {
+			writeJSONResponse(w, http.StatusBadRequest, Response{Code: "FAIL", Message: err.Error()})
+			return
+		}
+		writeJSONResponse(w, http.StatusOK, resp)
+	}
+}
+
+func writeJSONResponse(w http.ResponseWriter, statusCode int, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,100 @@
+package callback
+
+import (
+	"fmt"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// EventType identifies which registered handler an Envelope's
+// decrypted resource should be dispatched to.
+type EventType string
+
+const (
+	// EventPayNotify is a successful (or failed) payment notification.
+	EventPayNotify EventType = "PayNotify"
+
+	// EventRefundNotify is a refund outcome notification.
+	EventRefundNotify EventType = "RefundNotify"
+)
+
+// Resource is the encrypted payload every gateway in this package's
+// scope wraps a callback's actual content in — WeChat Pay calls the
+// outer JSON document a "resource", Alipay calls it "biz_content",
+// but the shape (algorithm, nonce, associated data, ciphertext) is the
+// same AES-256-GCM envelope.
+type Resource struct {
+	// Algorithm is almost always "AEAD_AES_256_GCM"; Handler rejects
+	// anything else rather than assume AES-GCM semantics apply.
+	Algorithm      string `json:"algorithm"`
+	Nonce          string `json:"nonce"`
+	AssociatedData string `json:"associated_data"`
+	Ciphertext     string `json:"ciphertext"`
+}
+
+// Envelope is the outer JSON document a gateway POSTs to a merchant's
+// callback endpoint: a certificate serial identifying which signing
+// key to verify the request with, and the encrypted Resource.
+type Envelope struct {
+	ID         string   `json:"id"`
+	EventType  string   `json:"event_type"`
+	Summary    string   `json:"summary"`
+	CertSerial string   `json:"cert_serial"`
+	Resource   Resource `json:"resource"`
+}
+
+// PayNotifyPayload is the decrypted content of an EventPayNotify
+// callback. Total/CurrencyCode mirror how gateways actually encode an
+// amount (an integer count of minor units plus an ISO 4217 code); call
+// Amount to convert it to a money.Amount.
+type PayNotifyPayload struct {
+	OutTradeNo    string `json:"out_trade_no"`
+	TransactionID string `json:"transaction_id"`
+	TradeState    string `json:"trade_state"`
+	Total         int64  `json:"total"`
+	CurrencyCode  string `json:"currency"`
+	PayerID       string `json:"payer_id"`
+	SuccessTime   string `json:"success_time"`
+}
+
+// Amount converts Total/CurrencyCode into a money.Amount.
+func (p PayNotifyPayload) Amount() (money.Amount, error) {
+	cur, ok := money.GetCurrency(p.CurrencyCode)
+	if !ok {
+		return money.Amount{}, fmt.Errorf("callback: unknown currency %q", p.CurrencyCode)
+	}
+	return money.NewFromMinorInt64(cur, p.Total), nil
+}
+
+// RefundNotifyPayload is the decrypted content of an
+// EventRefundNotify callback.
+type RefundNotifyPayload struct {
+	OutTradeNo   string `json:"out_trade_no"`
+	OutRefundNo  string `json:"out_refund_no"`
+	RefundID     string `json:"refund_id"`
+	RefundStatus string `json:"refund_status"`
+	RefundTotal  int64  `json:"refund_total"`
+	CurrencyCode string `json:"currency"`
+	SuccessTime  string `json:"success_time"`
+}
+
+// RefundAmount converts RefundTotal/CurrencyCode into a money.Amount.
+func (p RefundNotifyPayload) RefundAmount() (money.Amount, error) {
+	cur, ok := money.GetCurrency(p.CurrencyCode)
+	if !ok {
+		return money.Amount{}, fmt.Errorf("callback: unknown currency %q", p.CurrencyCode)
+	}
+	return money.NewFromMinorInt64(cur, p.RefundTotal), nil
+}
+
+// Response is what Handler returns to a gateway after processing a
+// callback, in the "code"/"message" shape WeChat Pay, Alipay, PayNow,
+// and VNPay all expect to stop their retry schedule.
+type Response struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Accepted is the Response a registered handler should return to
+// acknowledge a callback and stop the gateway from retrying it.
+var Accepted = Response{Code: "SUCCESS", Message: ""}
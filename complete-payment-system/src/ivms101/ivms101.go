@@ -0,0 +1,117 @@
+// Package ivms101 implements the interVASP Messaging Standard 101 data
+// model used to exchange originator and beneficiary information between
+// virtual asset service providers under the FATF Travel Rule
+// (Recommendation 16).
+package ivms101
+
+import "encoding/json"
+
+// NationalIdentification identifies a natural or legal person by a
+// government-issued number (passport, national ID, tax number, LEI, ...).
+type NationalIdentification struct {
+	NationalIdentifier     string `json:"national_identifier"`
+	NationalIdentifierType string `json:"national_identifier_type"` // e.g. "ARNU", "CCPT", "RAID", "LEIX"
+	CountryOfIssue         string `json:"country_of_issue,omitempty"`
+}
+
+// DateAndPlaceOfBirth records a natural person's birth details.
+type DateAndPlaceOfBirth struct {
+	DateOfBirth  string `json:"date_of_birth"` // YYYY-MM-DD
+	PlaceOfBirth string `json:"place_of_birth"`
+}
+
+// GeographicAddress is the IVMS 101 postal address structure.
+type GeographicAddress struct {
+	AddressType    string `json:"address_type"` // "HOME", "BIZZ", "GEOG"
+	AddressLine1   string `json:"address_line_1,omitempty"`
+	AddressLine2   string `json:"address_line_2,omitempty"`
+	StreetName     string `json:"street_name,omitempty"`
+	BuildingNumber string `json:"building_number,omitempty"`
+	PostCode       string `json:"post_code,omitempty"`
+	TownName       string `json:"town_name,omitempty"`
+	Country        string `json:"country"`
+}
+
+// NaturalPersonName is an IVMS 101 name identifier for a natural person.
+type NaturalPersonName struct {
+	PrimaryIdentifier   string `json:"primary_identifier"`   // surname
+	SecondaryIdentifier string `json:"secondary_identifier"` // given name
+	NameIdentifierType  string `json:"name_identifier_type"` // "LEGL", "ALIA", "MAID", "AKAN"
+}
+
+// NaturalPerson is an IVMS 101 naturalPerson record.
+type NaturalPerson struct {
+	Name                   NaturalPersonName       `json:"name"`
+	Address                *GeographicAddress      `json:"address,omitempty"`
+	NationalIdentification *NationalIdentification `json:"national_identification,omitempty"`
+	DateAndPlaceOfBirth    *DateAndPlaceOfBirth    `json:"date_and_place_of_birth,omitempty"`
+	CountryOfResidence     string                  `json:"country_of_residence,omitempty"`
+}
+
+// LegalPersonName is an IVMS 101 name identifier for a legal person.
+type LegalPersonName struct {
+	LegalName          string `json:"legal_name"`
+	NameIdentifierType string `json:"name_identifier_type"` // "LEGL", "TRAD", "SHRT"
+}
+
+// LegalPerson is an IVMS 101 legalPerson record.
+type LegalPerson struct {
+	Name                   LegalPersonName         `json:"name"`
+	Address                *GeographicAddress      `json:"address,omitempty"`
+	NationalIdentification *NationalIdentification `json:"national_identification,omitempty"`
+	CountryOfRegistration  string                  `json:"country_of_registration,omitempty"`
+}
+
+// Person is an IVMS 101 person union: exactly one of NaturalPerson or
+// LegalPerson should be set.
+type Person struct {
+	NaturalPerson *NaturalPerson `json:"natural_person,omitempty"`
+	LegalPerson   *LegalPerson   `json:"legal_person,omitempty"`
+}
+
+// Originator is the IVMS 101 originator group.
+type Originator struct {
+	OriginatorPersons []Person `json:"originator_persons"`
+	AccountNumber     string   `json:"account_number,omitempty"`
+}
+
+// Beneficiary is the IVMS 101 beneficiary group.
+type Beneficiary struct {
+	BeneficiaryPersons []Person `json:"beneficiary_persons"`
+	AccountNumber      string   `json:"account_number,omitempty"`
+}
+
+// VASP identifies a virtual asset service provider as a legal person,
+// plus its VASP-specific identifier (e.g. an LEI or national VASP
+// registration number).
+type VASP struct {
+	Name                   LegalPersonName         `json:"name"`
+	Address                *GeographicAddress      `json:"address,omitempty"`
+	NationalIdentification *NationalIdentification `json:"national_identification,omitempty"`
+	CountryOfRegistration  string                  `json:"country_of_registration,omitempty"`
+	VASPIdentifier         string                  `json:"vasp_identifier,omitempty"`
+}
+
+// OriginatingVASP is the IVMS 101 originating VASP group.
+type OriginatingVASP struct {
+	VASP VASP `json:"originating_vasp"`
+}
+
+// BeneficiaryVASP is the IVMS 101 beneficiary VASP group.
+type BeneficiaryVASP struct {
+	VASP VASP `json:"beneficiary_vasp"`
+}
+
+// Message is a complete IVMS 101 Travel Rule payload exchanged between
+// an originating and a beneficiary VASP for a single transfer.
+type Message struct {
+	Originator      Originator      `json:"originator"`
+	Beneficiary     Beneficiary     `json:"beneficiary"`
+	OriginatingVASP OriginatingVASP `json:"originating_vasp"`
+	BeneficiaryVASP BeneficiaryVASP `json:"beneficiary_vasp"`
+}
+
+// EncodeIVMS101 produces the canonical JSON representation of m.
+func EncodeIVMS101(m Message) ([]byte, error) {
+	return json.Marshal(m)
+}
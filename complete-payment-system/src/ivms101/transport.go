@@ -0,0 +1,53 @@
+package ivms101
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CounterpartyVASP is the connection details for a counterparty VASP's
+// Travel Rule endpoint, as opposed to the VASP type in ivms101.go which
+// describes identity fields carried inside a Message.
+type CounterpartyVASP struct {
+	Name string
+	URL  string
+
+	// PublicKey is the counterparty's static X25519 public key,
+	// published out-of-band (a bilateral key exchange, a TRISA/TRP
+	// directory entry, ...), used to encrypt Travel Rule payloads sent
+	// to them (see travelrule.Seal). Leave nil when the integration
+	// relies on transport-layer TLS alone instead of payload-level
+	// encryption.
+	PublicKey []byte
+}
+
+// TransmitTravelRule sends m to counterparty over a synchronous
+// HTTPS/JSON POST. It is a stub transport: integrators that need a TRP
+// or TRISA-compatible request/approve/confirm exchange can implement
+// the same signature against their own protocol without touching
+// country module code.
+func TransmitTravelRule(ctx context.Context, counterparty CounterpartyVASP, m Message) error {
+	payload, err := EncodeIVMS101(m)
+	if err != nil {
+		return fmt.Errorf("ivms101: encoding travel rule message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, counterparty.URL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("ivms101: building request to %s: %w", counterparty.Name, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("ivms101: transmitting travel rule message to %s: %w", counterparty.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("ivms101: counterparty %s rejected travel rule message: %s", counterparty.Name, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Package money provides a precision-safe representation of monetary
+// values. float64 silently loses precision for satoshi-level BTC amounts
+// and for high-magnitude fiat like IDR or VND, so every amount is stored as
+// an integer count of minor units alongside the Currency that defines how
+// many decimal places that minor unit has.
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrCurrencyMismatch is returned when an operation is attempted between
+// two Amounts denominated in different currencies.
+var ErrCurrencyMismatch = errors.New("money: currency mismatch")
+
+// Amount is a monetary value expressed as an integer number of minor units
+// (e.g. cents, satoshis) of a given Currency.
+type Amount struct {
+	currency Currency
+	minor    *big.Int
+}
+
+// Zero returns a zero-value Amount in the given currency.
+func Zero(currency Currency) Amount {
+	return Amount{currency: currency, minor: big.NewInt(0)}
+}
+
+// New constructs an Amount from an exact count of minor units.
+func New(currency Currency, minorUnits *big.Int) Amount {
+	return Amount{currency: currency, minor: new(big.Int).Set(minorUnits)}
+}
+
+// NewFromMinorInt64 constructs an Amount from an int64 count of minor units.
+func NewFromMinorInt64(currency Currency, minorUnits int64) Amount {
+	return Amount{currency: currency, minor: big.NewInt(minorUnits)}
+}
+
+// Parse parses a decimal string such as "123.45" into an Amount denominated
+// in currency, rejecting more fractional digits than the currency's
+// Decimals allows.
+func Parse(s string, currency Currency) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Amount{}, errors.New("money: cannot parse empty string")
+	}
+
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	}
+
+	whole, frac, hasFrac := strings.Cut(s, ".")
+	if hasFrac {
+		if len(frac) > currency.Decimals {
+			return Amount{}, fmt.Errorf("money: %q has more than %d decimal places for %s", s, currency.Decimals, currency.Code)
+		}
+		frac = frac + strings.Repeat("0", currency.Decimals-len(frac))
+	} else {
+		frac = strings.Repeat("0", currency.Decimals)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+
+	minor, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return Amount{}, fmt.Errorf("money: %q is not a valid amount", s)
+	}
+	if neg {
+		minor.Neg(minor)
+	}
+
+	return Amount{currency: currency, minor: minor}, nil
+}
+
+// FromMinorRat builds an Amount from an exact count of minor units
+// expressed as a rational number, rounding to the nearest whole minor unit
+// (ties away from zero). It is used when a conversion or rate calculation
+// produces a fractional minor-unit count, e.g. converting between
+// currencies with different numbers of decimals.
+func FromMinorRat(currency Currency, minorUnits *big.Rat) Amount {
+	return Amount{currency: currency, minor: roundRat(minorUnits)}
+}
+
+// Currency returns the currency this amount is denominated in.
+func (a Amount) Currency() Currency {
+	return a.currency
+}
+
+// MinorUnits returns the underlying integer count of minor units.
+func (a Amount) MinorUnits() *big.Int {
+	return new(big.Int).Set(a.minor)
+}
+
+// IsZero reports whether the amount is exactly zero.
+func (a Amount) IsZero() bool {
+	return a.minor == nil || a.minor.Sign() == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the amount.
+func (a Amount) Sign() int {
+	if a.minor == nil {
+		return 0
+	}
+	return a.minor.Sign()
+}
+
+func (a Amount) requireSameCurrency(b Amount) error {
+	if a.currency.Code != b.currency.Code {
+		return fmt.Errorf("%w: %s vs %s", ErrCurrencyMismatch, a.currency.Code, b.currency.Code)
+	}
+	return nil
+}
+
+// Add returns a+b. Both amounts must be denominated in the same currency.
+func (a Amount) Add(b Amount) (Amount, error) {
+	if err := a.requireSameCurrency(b); err != nil {
+		return Amount{}, err
+	}
+	return Amount{currency: a.currency, minor: new(big.Int).Add(a.minor, b.minor)}, nil
+}
+
+// Sub returns a-b. Both amounts must be denominated in the same currency.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if err := a.requireSameCurrency(b); err != nil {
+		return Amount{}, err
+	}
+	return Amount{currency: a.currency, minor: new(big.Int).Sub(a.minor, b.minor)}, nil
+}
+
+// Cmp compares a to b, returning -1, 0 or +1. Both amounts must be
+// denominated in the same currency.
+func (a Amount) Cmp(b Amount) (int, error) {
+	if err := a.requireSameCurrency(b); err != nil {
+		return 0, err
+	}
+	return a.minor.Cmp(b.minor), nil
+}
+
+// Mul multiplies the amount by a rational scalar (e.g. a tax rate or an
+// exchange spread), rounding the result to the nearest minor unit.
+// Ties round away from zero.
+func (a Amount) Mul(factor *big.Rat) Amount {
+	product := new(big.Rat).Mul(new(big.Rat).SetInt(a.minor), factor)
+	return Amount{currency: a.currency, minor: roundRat(product)}
+}
+
+// Round rounds the amount to the nearest multiple of tickSize (a decimal
+// string such as "0.01"), returning a new Amount in the same currency.
+func (a Amount) Round(tickSize string) (Amount, error) {
+	tick, err := Parse(tickSize, a.currency)
+	if err != nil {
+		return Amount{}, fmt.Errorf("money: invalid tick size: %w", err)
+	}
+	if tick.minor.Sign() == 0 {
+		return Amount{}, errors.New("money: tick size cannot be zero")
+	}
+
+	quotient := new(big.Rat).SetFrac(a.minor, tick.minor)
+	ticks := roundRat(quotient)
+	return Amount{currency: a.currency, minor: new(big.Int).Mul(ticks, tick.minor)}, nil
+}
+
+// roundRat rounds a rational number to the nearest integer, ties away from
+// zero.
+func roundRat(r *big.Rat) *big.Int {
+	half := big.NewRat(1, 2)
+	if r.Sign() >= 0 {
+		shifted := new(big.Rat).Add(r, half)
+		return new(big.Int).Quo(shifted.Num(), shifted.Denom())
+	}
+	shifted := new(big.Rat).Sub(r, half)
+	return new(big.Int).Quo(shifted.Num(), shifted.Denom())
+}
+
+// String formats the amount using the currency's decimal places, e.g.
+// "BTC:0.00012345".
+func (a Amount) String() string {
+	if a.minor == nil {
+		return a.currency.Code + ":0"
+	}
+
+	neg := a.minor.Sign() < 0
+	abs := new(big.Int).Abs(a.minor)
+	digits := abs.String()
+
+	decimals := a.currency.Decimals
+	if decimals == 0 {
+		if neg {
+			return fmt.Sprintf("%s:-%s", a.currency.Code, digits)
+		}
+		return fmt.Sprintf("%s:%s", a.currency.Code, digits)
+	}
+
+	for len(digits) <= decimals {
+		digits = "0" + digits
+	}
+	whole := digits[:len(digits)-decimals]
+	frac := digits[len(digits)-decimals:]
+
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s:%s%s.%s", a.currency.Code, sign, whole, frac)
+}
+
+// MarshalJSON emits the amount as a "CODE:decimal" string.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON parses a "CODE:decimal" string produced by MarshalJSON. The
+// currency must already be registered.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	code, decimal, ok := strings.Cut(s, ":")
+	if !ok {
+		return fmt.Errorf("money: %q is not in \"CODE:amount\" format", s)
+	}
+
+	currency, ok := GetCurrency(code)
+	if !ok {
+		return fmt.Errorf("money: unknown currency %q", code)
+	}
+
+	parsed, err := Parse(decimal, currency)
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
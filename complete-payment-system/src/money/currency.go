@@ -0,0 +1,88 @@
+package money
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Currency describes a unit of account: how many decimal places its minor
+// unit representation uses, and (for assets traded against each other) the
+// tick sizes a venue will accept for amounts and prices. This mirrors how
+// exchange connectors register per-asset precision instead of assuming a
+// fixed number of decimals for every currency.
+type Currency struct {
+	// Code is the ISO 4217 code for fiat currencies (e.g. "MYR") or the
+	// ticker for cryptocurrencies (e.g. "BTC").
+	Code string
+
+	// Decimals is the number of digits after the decimal point used by the
+	// currency's minor unit (e.g. 2 for MYR cents, 8 for BTC satoshis).
+	Decimals int
+
+	// AmountTickSize is the smallest increment a trade amount may be
+	// expressed in, as a decimal string (e.g. "0.00000001" for BTC). Empty
+	// means amounts are only constrained by Decimals.
+	AmountTickSize string
+
+	// PriceTickSize is the smallest increment a quoted price may move by,
+	// as a decimal string. Empty means no additional constraint.
+	PriceTickSize string
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[string]Currency{}
+)
+
+func init() {
+	// Fiat currencies referenced by the country modules, seeded with their
+	// correct ISO 4217 minor-unit decimals.
+	for _, c := range []Currency{
+		{Code: "USD", Decimals: 2},
+		{Code: "SGD", Decimals: 2},
+		{Code: "BND", Decimals: 2},
+		{Code: "MYR", Decimals: 2},
+		{Code: "THB", Decimals: 2},
+		{Code: "IDR", Decimals: 2},
+		{Code: "VND", Decimals: 0},
+		{Code: "KHR", Decimals: 2},
+		{Code: "LAK", Decimals: 2},
+		{Code: "PHP", Decimals: 2},
+		{Code: "JPY", Decimals: 0},
+		{Code: "KRW", Decimals: 0},
+		// Cryptocurrencies, with conventional on-chain precision and the
+		// tick sizes commonly enforced by spot venues.
+		{Code: "BTC", Decimals: 8, AmountTickSize: "0.00000001", PriceTickSize: "0.01"},
+		{Code: "ETH", Decimals: 18, AmountTickSize: "0.000001", PriceTickSize: "0.01"},
+		{Code: "USDT", Decimals: 6, AmountTickSize: "0.01", PriceTickSize: "0.0001"},
+		{Code: "BNB", Decimals: 18, AmountTickSize: "0.00001", PriceTickSize: "0.01"},
+	} {
+		registry[c.Code] = c
+	}
+}
+
+// RegisterCurrency adds or replaces a currency definition in the registry.
+func RegisterCurrency(c Currency) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	registry[c.Code] = c
+}
+
+// GetCurrency looks up a currency by its code.
+func GetCurrency(code string) (Currency, bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	c, ok := registry[code]
+	return c, ok
+}
+
+// MustGetCurrency looks up a currency by its code, panicking if it is not
+// registered. It is intended for package-level initialization of well-known
+// currencies, not for handling user input.
+func MustGetCurrency(code string) Currency {
+	c, ok := GetCurrency(code)
+	if !ok {
+		panic(fmt.Sprintf("money: currency %q is not registered", code))
+	}
+	return c
+}
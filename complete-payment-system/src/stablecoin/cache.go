@@ -0,0 +1,155 @@
+package stablecoin
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// Policy is the set of checks Cache.Evaluate enforces against a cached
+// Attestation. A zero value in any field disables that particular
+// check.
+type Policy struct {
+	// MaxStaleness rejects an attestation whose AttestedAt is older
+	// than this.
+	MaxStaleness time.Duration
+
+	// MaxPegDeviationBps rejects an attestation whose PegDeviationBps
+	// exceeds this.
+	MaxPegDeviationBps int
+
+	// MinReserveRatio rejects an attestation whose ReservesUSD /
+	// CirculatingSupply ratio falls below this (e.g. big.NewRat(1, 1)
+	// to require full backing).
+	MinReserveRatio *big.Rat
+}
+
+// Cache holds the most recently fetched Attestation per asset and
+// evaluates it against Policy.
+type Cache struct {
+	Source AttestationSource
+	Policy Policy
+
+	mu     sync.RWMutex
+	status map[string]Attestation
+}
+
+// NewCache creates a Cache that fetches through source and evaluates
+// against policy.
+func NewCache(source AttestationSource, policy Policy) *Cache {
+	return &Cache{Source: source, Policy: policy, status: make(map[string]Attestation)}
+}
+
+// Refresh fetches a fresh attestation for every asset in assets and
+// replaces their cached entries. It returns the first fetch error
+// encountered (after attempting every asset), leaving previously
+// cached entries for assets that failed untouched.
+func (c *Cache) Refresh(ctx context.Context, assets []string) error {
+	var firstErr error
+	for _, asset := range assets {
+		attestation, err := c.Source.FetchAttestation(ctx, asset)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("stablecoin: fetching attestation for %s: %w", asset, err)
+			}
+			continue
+		}
+		attestation.Asset = asset
+		attestation.ObservedAt = time.Now()
+
+		c.mu.Lock()
+		c.status[asset] = attestation
+		c.mu.Unlock()
+	}
+	return firstErr
+}
+
+// Run calls Refresh every interval until ctx is canceled. It's meant
+// to be started once, typically as `go cache.Run(ctx, assets, interval)`
+// during application startup.
+func (c *Cache) Run(ctx context.Context, assets []string, interval time.Duration) {
+	for {
+		c.Refresh(ctx, assets)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Get returns the most recently cached attestation for asset.
+func (c *Cache) Get(asset string) (Attestation, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	a, ok := c.status[asset]
+	return a, ok
+}
+
+// All returns every cached attestation, keyed by asset.
+func (c *Cache) All() map[string]Attestation {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[string]Attestation, len(c.status))
+	for k, v := range c.status {
+		out[k] = v
+	}
+	return out
+}
+
+// Evaluate checks asset's cached attestation against c.Policy,
+// returning a descriptive error if no attestation has been cached yet,
+// or if it is stale, off-peg, or under-reserved.
+func (c *Cache) Evaluate(asset string) error {
+	attestation, ok := c.Get(asset)
+	if !ok {
+		return fmt.Errorf("stablecoin: no reserve attestation cached for %s", asset)
+	}
+
+	if c.Policy.MaxStaleness > 0 && !attestation.AttestedAt.IsZero() {
+		if age := time.Since(attestation.AttestedAt); age > c.Policy.MaxStaleness {
+			return fmt.Errorf("stablecoin: %s attestation is %s old, exceeding the %s staleness limit", asset, age.Round(time.Second), c.Policy.MaxStaleness)
+		}
+	}
+
+	if c.Policy.MaxPegDeviationBps > 0 {
+		deviation := attestation.PegDeviationBps
+		if deviation < 0 {
+			deviation = -deviation
+		}
+		if deviation > c.Policy.MaxPegDeviationBps {
+			return fmt.Errorf("stablecoin: %s is %d bps off peg, exceeding the %d bps limit", asset, deviation, c.Policy.MaxPegDeviationBps)
+		}
+	}
+
+	if c.Policy.MinReserveRatio != nil {
+		supply := decimalValue(attestation.CirculatingSupply)
+		if supply.Sign() <= 0 {
+			// A non-positive circulating supply is not "no constraint to
+			// check" — it means the attestation has no usable supply
+			// figure (missing/malformed data), which is itself a reason
+			// to reject rather than silently pass an unverifiable ratio.
+			return fmt.Errorf("stablecoin: %s attestation reports no circulating supply, cannot verify reserve ratio", asset)
+		}
+		reserves := decimalValue(attestation.ReservesUSD)
+		ratio := new(big.Rat).Quo(reserves, supply)
+		if ratio.Cmp(c.Policy.MinReserveRatio) < 0 {
+			return fmt.Errorf("stablecoin: %s reserve ratio %s is below the required %s", asset, ratio.FloatString(4), c.Policy.MinReserveRatio.FloatString(4))
+		}
+	}
+
+	return nil
+}
+
+// decimalValue converts a money.Amount to its exact decimal value
+// (minor units / 10^decimals) as a big.Rat, so reserves and supply
+// (each denominated in its own currency's minor units) can be compared
+// as plain numbers.
+func decimalValue(a money.Amount) *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(a.Currency().Decimals)), nil)
+	return new(big.Rat).SetFrac(a.MinorUnits(), scale)
+}
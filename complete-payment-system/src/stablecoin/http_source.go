@@ -0,0 +1,83 @@
+package stablecoin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ParseFunc decodes an issuer's attestation response body into an
+// Attestation. Each issuer publishes its own response shape (Circle's
+// reserves endpoint and Tether's transparency page don't agree on a
+// format), so HTTPAttestationSource takes one parser per asset instead
+// of assuming a common schema.
+type ParseFunc func(body []byte) (Attestation, error)
+
+// HTTPAttestationSource fetches each asset's attestation from a
+// per-asset URL registered via Register, and decodes the response with
+// that asset's registered ParseFunc.
+type HTTPAttestationSource struct {
+	HTTPClient *http.Client
+
+	mu       sync.RWMutex
+	endpoint map[string]string
+	parse    map[string]ParseFunc
+}
+
+// NewHTTPAttestationSource creates an empty HTTPAttestationSource.
+// Register at least one asset before calling FetchAttestation.
+func NewHTTPAttestationSource() *HTTPAttestationSource {
+	return &HTTPAttestationSource{
+		HTTPClient: http.DefaultClient,
+		endpoint:   make(map[string]string),
+		parse:      make(map[string]ParseFunc),
+	}
+}
+
+// Register associates asset with the URL its issuer publishes
+// attestation data at, and the ParseFunc that decodes it.
+func (s *HTTPAttestationSource) Register(asset, url string, parse ParseFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.endpoint[asset] = url
+	s.parse[asset] = parse
+}
+
+// FetchAttestation implements AttestationSource.
+func (s *HTTPAttestationSource) FetchAttestation(ctx context.Context, asset string) (Attestation, error) {
+	s.mu.RLock()
+	url, hasURL := s.endpoint[asset]
+	parse, hasParser := s.parse[asset]
+	s.mu.RUnlock()
+	if !hasURL || !hasParser {
+		return Attestation{}, fmt.Errorf("stablecoin: no attestation source registered for %s", asset)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Attestation{}, err
+	}
+
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Attestation{}, fmt.Errorf("stablecoin: fetching %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Attestation{}, fmt.Errorf("stablecoin: %s returned HTTP %d", url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Attestation{}, fmt.Errorf("stablecoin: reading response from %s: %w", url, err)
+	}
+
+	return parse(body)
+}
@@ -0,0 +1,41 @@
+// Package stablecoin lets a country module verify a fiat-backed
+// stablecoin is actually backed before accepting a transaction
+// denominated in it, instead of trusting its classification (e.g.
+// Cambodia's NBC "Group 1b") alone. A Cache periodically fetches each
+// issuer's public reserve attestation (Circle's reserves endpoint for
+// USDC, Tether's transparency page, ...) through an AttestationSource
+// and evaluates it against a configurable staleness/peg/reserve-ratio
+// Policy.
+package stablecoin
+
+import (
+	"context"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// Attestation is an issuer's most recent reserve disclosure for one
+// stablecoin asset.
+type Attestation struct {
+	Asset string
+
+	// AttestedAt is the issuer's own "as of" timestamp for this
+	// attestation, used for staleness checks. ObservedAt, set by
+	// Cache.Refresh, is when this Cache fetched it.
+	AttestedAt time.Time
+	ObservedAt time.Time
+
+	ReservesUSD       money.Amount
+	CirculatingSupply money.Amount
+
+	// PegDeviationBps is the asset's current deviation from its peg,
+	// in basis points (e.g. 25 means 0.25% off peg), unsigned.
+	PegDeviationBps int
+}
+
+// AttestationSource fetches the latest attestation for asset from its
+// issuer.
+type AttestationSource interface {
+	FetchAttestation(ctx context.Context, asset string) (Attestation, error)
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// AggregateTransactions pages through every transaction matching filter
+// and returns the count and the running total, summed in zero's
+// currency. It's the building block country modules use to turn
+// GenerateReports from a placeholder into a real query.
+func AggregateTransactions(ctx context.Context, store TransactionStore, filter Filter, zero money.Amount) (int, money.Amount, error) {
+	count := 0
+	total := zero
+
+	for {
+		txs, next, err := store.Query(ctx, filter)
+		if err != nil {
+			return 0, money.Amount{}, err
+		}
+
+		for _, tx := range txs {
+			if tx.Amount.Currency().Code != zero.Currency().Code {
+				// A transaction denominated in a different currency than
+				// the report; skip it rather than fail the whole report.
+				continue
+			}
+			summed, err := total.Add(tx.Amount)
+			if err != nil {
+				return 0, money.Amount{}, err
+			}
+			total = summed
+			count++
+		}
+
+		if next == "" {
+			break
+		}
+		filter.After = next
+	}
+
+	return count, total, nil
+}
@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// encodeCursor builds an opaque Cursor from the last row's sort key
+// (created_at, id), for keyset pagination.
+func encodeCursor(createdAt time.Time, id string) Cursor {
+	raw := fmt.Sprintf("%d:%s", createdAt.UnixNano(), id)
+	return Cursor(base64.RawURLEncoding.EncodeToString([]byte(raw)))
+}
+
+// decodeCursor recovers the sort key encoded by encodeCursor.
+func decodeCursor(c Cursor) (createdAt time.Time, id string, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(string(c))
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("storage: invalid cursor: %w", err)
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return time.Time{}, "", fmt.Errorf("storage: malformed cursor")
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("storage: malformed cursor timestamp: %w", err)
+	}
+	return time.Unix(0, nanos), parts[1], nil
+}
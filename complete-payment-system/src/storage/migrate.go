@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migration is a single versioned schema change, following the up/down
+// convention used by bbgo's rockhopper migrations: each version has a
+// "NNNN_name.up.sql" file to apply it and a "NNNN_name.down.sql" file to
+// revert it.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// loadMigrations reads every migration file embedded under migrations/
+// and assembles them into version-ordered Migration values.
+func loadMigrations() ([]Migration, error) {
+	entries, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		m := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		version, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("migrations: invalid version in %q: %w", entry.Name(), err)
+		}
+
+		contents, err := migrationFiles.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		switch m[3] {
+		case "up":
+			mig.Up = string(contents)
+		case "down":
+			mig.Down = string(contents)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator applies and rolls back schema migrations against a SQL
+// database. It works against both the SQLite and PostgreSQL backends
+// since it only relies on database/sql.
+type Migrator struct {
+	db *sql.DB
+}
+
+// NewMigrator creates a Migrator bound to db.
+func NewMigrator(db *sql.DB) *Migrator {
+	return &Migrator{db: db}
+}
+
+func (mig *Migrator) ensureSchemaTable(ctx context.Context) error {
+	_, err := mig.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	return err
+}
+
+func (mig *Migrator) appliedVersions(ctx context.Context) (map[int]bool, error) {
+	rows, err := mig.db.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+	return applied, rows.Err()
+}
+
+// ApplyAll runs every migration that hasn't yet been applied, in version
+// order.
+func (mig *Migrator) ApplyAll(ctx context.Context) error {
+	if err := mig.ensureSchemaTable(ctx); err != nil {
+		return fmt.Errorf("storage: creating schema_migrations table: %w", err)
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return fmt.Errorf("storage: loading migrations: %w", err)
+	}
+
+	applied, err := mig.appliedVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("storage: reading applied migrations: %w", err)
+	}
+
+	for _, m := range migrations {
+		if applied[m.Version] {
+			continue
+		}
+		if err := mig.apply(ctx, m); err != nil {
+			return fmt.Errorf("storage: applying migration %04d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+	return nil
+}
+
+func (mig *Migrator) apply(ctx context.Context, m Migration) error {
+	tx, err := mig.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range splitStatements(m.Up) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name) VALUES (?, ?)`, m.Version, m.Name); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Rollback reverts the most recently applied steps migrations, in
+// reverse version order.
+func (mig *Migrator) Rollback(ctx context.Context, steps int) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return fmt.Errorf("storage: loading migrations: %w", err)
+	}
+	byVersion := make(map[int]Migration, len(migrations))
+	for _, m := range migrations {
+		byVersion[m.Version] = m
+	}
+
+	applied, err := mig.appliedVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("storage: reading applied migrations: %w", err)
+	}
+	versions := make([]int, 0, len(applied))
+	for v := range applied {
+		versions = append(versions, v)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+	for i := 0; i < steps && i < len(versions); i++ {
+		m, ok := byVersion[versions[i]]
+		if !ok {
+			return fmt.Errorf("storage: no migration file found for applied version %d", versions[i])
+		}
+		if err := mig.revert(ctx, m); err != nil {
+			return fmt.Errorf("storage: reverting migration %04d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+	return nil
+}
+
+func (mig *Migrator) revert(ctx context.Context, m Migration) error {
+	tx, err := mig.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range splitStatements(m.Down) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = ?`, m.Version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// splitStatements breaks a migration file into individual statements on
+// ";" boundaries, skipping the "-- +up"/"-- +down" marker comment and
+// blank statements.
+func splitStatements(sqlText string) []string {
+	var body []string
+	for _, line := range strings.Split(sqlText, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "-- +") {
+			continue
+		}
+		body = append(body, line)
+	}
+
+	var statements []string
+	for _, raw := range strings.Split(strings.Join(body, "\n"), ";") {
+		stmt := strings.TrimSpace(raw)
+		if stmt == "" {
+			continue
+		}
+		statements = append(statements, stmt)
+	}
+	return statements
+}
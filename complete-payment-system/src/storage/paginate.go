@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// pollInterval is how often Paginate re-queries the store while
+// long-polling for a new record.
+const pollInterval = 200 * time.Millisecond
+
+// maxPageLimit caps ReportQuery.Limit so a single page request can't
+// force the backend to load an unbounded result set into memory.
+const maxPageLimit = 1000
+
+// normalizeOrder validates an Order value, defaulting to ascending.
+func normalizeOrder(order string) string {
+	if order == "desc" {
+		return "desc"
+	}
+	return "asc"
+}
+
+// normalizeLimit applies the default and cap used by every paginated
+// query in this package.
+func normalizeLimit(limit int) int {
+	if limit <= 0 {
+		return 100
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return limit
+}
+
+// Paginate runs query against store, honoring its cursor, limit, and
+// order. If the page comes back empty and query.TimeoutMs > 0, it
+// long-polls — retrying on a short interval — until at least one
+// record appears or the timeout elapses, so callers can stream months
+// of activity without loading everything into memory or busy-polling.
+func Paginate(ctx context.Context, store TransactionStore, countryCode string, query ReportQuery) (ReportPage, error) {
+	filter := Filter{
+		CountryCode: countryCode,
+		From:        query.Start,
+		To:          query.End,
+		Limit:       normalizeLimit(query.Limit),
+		Order:       normalizeOrder(query.Order),
+		After:       query.Cursor,
+	}
+
+	var deadline time.Time
+	if query.TimeoutMs > 0 {
+		deadline = time.Now().Add(time.Duration(query.TimeoutMs) * time.Millisecond)
+	}
+
+	for {
+		txs, next, err := store.Query(ctx, filter)
+		if err != nil {
+			return ReportPage{}, err
+		}
+
+		if len(txs) > 0 || query.TimeoutMs <= 0 || time.Now().After(deadline) {
+			items := make([]interface{}, len(txs))
+			for i, tx := range txs {
+				items[i] = tx
+			}
+			return ReportPage{Items: items, NextCursor: next, HasMore: next != ""}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ReportPage{}, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
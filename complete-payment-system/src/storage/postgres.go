@@ -0,0 +1,230 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/lib/pq"
+)
+
+// PostgresStore is the production TransactionStore and
+// ComplianceEventStore backend.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore opens a PostgreSQL connection using dsn and applies
+// any pending migrations.
+func NewPostgresStore(ctx context.Context, dsn string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("storage: opening postgres database: %w", err)
+	}
+
+	if err := NewMigrator(db).ApplyAll(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &PostgresStore{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
+
+// Save inserts or updates a transaction record.
+func (s *PostgresStore) Save(ctx context.Context, tx Transaction) error {
+	var completedAt interface{}
+	if !tx.CompletedAt.IsZero() {
+		completedAt = tx.CompletedAt
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO transactions (
+			id, country_code, merchant_id, customer_id, currency, amount_minor_units,
+			crypto_currency, source_address, destination_address, status,
+			created_at, updated_at, completed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO UPDATE SET
+			status = excluded.status,
+			updated_at = excluded.updated_at,
+			completed_at = excluded.completed_at
+	`,
+		tx.ID, tx.CountryCode, tx.MerchantID, tx.CustomerID, tx.Amount.Currency().Code, tx.Amount.MinorUnits().String(),
+		tx.CryptoCurrency, tx.SourceAddress, tx.DestinationAddress, tx.Status,
+		tx.CreatedAt, tx.UpdatedAt, completedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("storage: saving transaction %s: %w", tx.ID, err)
+	}
+	return nil
+}
+
+// Get retrieves a transaction by ID.
+func (s *PostgresStore) Get(ctx context.Context, id string) (Transaction, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT id, country_code, merchant_id, customer_id, currency, amount_minor_units,
+			crypto_currency, source_address, destination_address, status,
+			created_at, updated_at, completed_at
+		FROM transactions WHERE id = $1
+	`, id)
+	return scanTransaction(row)
+}
+
+// Query returns transactions matching filter, ordered by creation time,
+// along with a Cursor for the next page (empty when there are no more
+// results).
+func (s *PostgresStore) Query(ctx context.Context, filter Filter) ([]Transaction, Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `SELECT id, country_code, merchant_id, customer_id, currency, amount_minor_units,
+		crypto_currency, source_address, destination_address, status,
+		created_at, updated_at, completed_at
+		FROM transactions WHERE 1=1`
+	args := []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.CountryCode != "" {
+		query += " AND country_code = " + arg(filter.CountryCode)
+	}
+	if filter.MerchantID != "" {
+		query += " AND merchant_id = " + arg(filter.MerchantID)
+	}
+	if !filter.From.IsZero() {
+		query += " AND created_at >= " + arg(filter.From)
+	}
+	if !filter.To.IsZero() {
+		query += " AND created_at <= " + arg(filter.To)
+	}
+	desc := filter.Order == "desc"
+	cursorOp := ">"
+	if desc {
+		cursorOp = "<"
+	}
+	if filter.After != "" {
+		afterTime, afterID, err := decodeCursor(filter.After)
+		if err != nil {
+			return nil, "", err
+		}
+		query += fmt.Sprintf(" AND (created_at, id) %s (%s, %s)", cursorOp, arg(afterTime), arg(afterID))
+	}
+
+	if desc {
+		query += " ORDER BY created_at DESC, id DESC LIMIT " + arg(limit+1)
+	} else {
+		query += " ORDER BY created_at, id LIMIT " + arg(limit+1)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("storage: querying transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var results []Transaction
+	for rows.Next() {
+		tx, err := scanTransaction(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		results = append(results, tx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var next Cursor
+	if len(results) > limit {
+		last := results[limit-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+		results = results[:limit]
+	}
+	return results, next, nil
+}
+
+// Record inserts a compliance event.
+func (s *PostgresStore) Record(ctx context.Context, event ComplianceEvent) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO compliance_events (
+			id, country_code, merchant_id, transaction_id, event_type, regulator, outcome, detail, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, event.ID, event.CountryCode, event.MerchantID, event.TransactionID, event.EventType, event.Regulator, event.Outcome, event.Detail, event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("storage: recording compliance event: %w", err)
+	}
+	return nil
+}
+
+// QueryEvents returns compliance events matching filter, ordered by
+// creation time, along with a Cursor for the next page.
+func (s *PostgresStore) QueryEvents(ctx context.Context, filter Filter) ([]ComplianceEvent, Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `SELECT id, country_code, merchant_id, transaction_id, event_type, regulator, outcome, detail, created_at
+		FROM compliance_events WHERE 1=1`
+	args := []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.CountryCode != "" {
+		query += " AND country_code = " + arg(filter.CountryCode)
+	}
+	if filter.MerchantID != "" {
+		query += " AND merchant_id = " + arg(filter.MerchantID)
+	}
+	if !filter.From.IsZero() {
+		query += " AND created_at >= " + arg(filter.From)
+	}
+	if !filter.To.IsZero() {
+		query += " AND created_at <= " + arg(filter.To)
+	}
+	if filter.After != "" {
+		afterTime, afterID, err := decodeCursor(filter.After)
+		if err != nil {
+			return nil, "", err
+		}
+		query += fmt.Sprintf(" AND (created_at, id) > (%s, %s)", arg(afterTime), arg(afterID))
+	}
+
+	query += " ORDER BY created_at, id LIMIT " + arg(limit+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("storage: querying compliance events: %w", err)
+	}
+	defer rows.Close()
+
+	var results []ComplianceEvent
+	for rows.Next() {
+		var e ComplianceEvent
+		if err := rows.Scan(&e.ID, &e.CountryCode, &e.MerchantID, &e.TransactionID, &e.EventType, &e.Regulator, &e.Outcome, &e.Detail, &e.CreatedAt); err != nil {
+			return nil, "", err
+		}
+		results = append(results, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var next Cursor
+	if len(results) > limit {
+		last := results[limit-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+		results = results[:limit]
+	}
+	return results, next, nil
+}
@@ -0,0 +1,275 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// SQLiteStore is the embedded/dev TransactionStore and
+// ComplianceEventStore backend.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if necessary) a SQLite database at path
+// and applies any pending migrations.
+func NewSQLiteStore(ctx context.Context, path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("storage: opening sqlite database: %w", err)
+	}
+
+	if err := NewMigrator(db).ApplyAll(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+// Save inserts or replaces a transaction record.
+func (s *SQLiteStore) Save(ctx context.Context, tx Transaction) error {
+	var completedAt interface{}
+	if !tx.CompletedAt.IsZero() {
+		completedAt = tx.CompletedAt
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO transactions (
+			id, country_code, merchant_id, customer_id, currency, amount_minor_units,
+			crypto_currency, source_address, destination_address, status,
+			created_at, updated_at, completed_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			status = excluded.status,
+			updated_at = excluded.updated_at,
+			completed_at = excluded.completed_at
+	`,
+		tx.ID, tx.CountryCode, tx.MerchantID, tx.CustomerID, tx.Amount.Currency().Code, tx.Amount.MinorUnits().String(),
+		tx.CryptoCurrency, tx.SourceAddress, tx.DestinationAddress, tx.Status,
+		tx.CreatedAt, tx.UpdatedAt, completedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("storage: saving transaction %s: %w", tx.ID, err)
+	}
+	return nil
+}
+
+// Get retrieves a transaction by ID.
+func (s *SQLiteStore) Get(ctx context.Context, id string) (Transaction, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT id, country_code, merchant_id, customer_id, currency, amount_minor_units,
+			crypto_currency, source_address, destination_address, status,
+			created_at, updated_at, completed_at
+		FROM transactions WHERE id = ?
+	`, id)
+	return scanTransaction(row)
+}
+
+// Query returns transactions matching filter, ordered by creation time,
+// along with a Cursor for the next page (empty when there are no more
+// results).
+func (s *SQLiteStore) Query(ctx context.Context, filter Filter) ([]Transaction, Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `SELECT id, country_code, merchant_id, customer_id, currency, amount_minor_units,
+		crypto_currency, source_address, destination_address, status,
+		created_at, updated_at, completed_at
+		FROM transactions WHERE 1=1`
+	args := []interface{}{}
+
+	if filter.CountryCode != "" {
+		query += " AND country_code = ?"
+		args = append(args, filter.CountryCode)
+	}
+	if filter.MerchantID != "" {
+		query += " AND merchant_id = ?"
+		args = append(args, filter.MerchantID)
+	}
+	if !filter.From.IsZero() {
+		query += " AND created_at >= ?"
+		args = append(args, filter.From)
+	}
+	if !filter.To.IsZero() {
+		query += " AND created_at <= ?"
+		args = append(args, filter.To)
+	}
+	desc := filter.Order == "desc"
+	cursorOp := ">"
+	if desc {
+		cursorOp = "<"
+	}
+	if filter.After != "" {
+		afterTime, afterID, err := decodeCursor(filter.After)
+		if err != nil {
+			return nil, "", err
+		}
+		query += fmt.Sprintf(" AND (created_at, id) %s (?, ?)", cursorOp)
+		args = append(args, afterTime, afterID)
+	}
+
+	if desc {
+		query += " ORDER BY created_at DESC, id DESC LIMIT ?"
+	} else {
+		query += " ORDER BY created_at, id LIMIT ?"
+	}
+	args = append(args, limit+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("storage: querying transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var results []Transaction
+	for rows.Next() {
+		tx, err := scanTransaction(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		results = append(results, tx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var next Cursor
+	if len(results) > limit {
+		last := results[limit-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+		results = results[:limit]
+	}
+	return results, next, nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTransaction(row rowScanner) (Transaction, error) {
+	var (
+		tx           Transaction
+		currencyCode string
+		amountMinor  string
+		completedAt  sql.NullTime
+	)
+	if err := row.Scan(
+		&tx.ID, &tx.CountryCode, &tx.MerchantID, &tx.CustomerID, &currencyCode, &amountMinor,
+		&tx.CryptoCurrency, &tx.SourceAddress, &tx.DestinationAddress, &tx.Status,
+		&tx.CreatedAt, &tx.UpdatedAt, &completedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return Transaction{}, fmt.Errorf("storage: transaction not found")
+		}
+		return Transaction{}, err
+	}
+
+	cur, ok := money.GetCurrency(currencyCode)
+	if !ok {
+		return Transaction{}, fmt.Errorf("storage: unknown currency %q", currencyCode)
+	}
+	minorUnits, ok := new(big.Int).SetString(amountMinor, 10)
+	if !ok {
+		return Transaction{}, fmt.Errorf("storage: parsing stored amount %q", amountMinor)
+	}
+	tx.Amount = money.New(cur, minorUnits)
+	if completedAt.Valid {
+		tx.CompletedAt = completedAt.Time
+	}
+	return tx, nil
+}
+
+// Record inserts a compliance event.
+func (s *SQLiteStore) Record(ctx context.Context, event ComplianceEvent) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO compliance_events (
+			id, country_code, merchant_id, transaction_id, event_type, regulator, outcome, detail, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, event.ID, event.CountryCode, event.MerchantID, event.TransactionID, event.EventType, event.Regulator, event.Outcome, event.Detail, event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("storage: recording compliance event: %w", err)
+	}
+	return nil
+}
+
+// Query returns compliance events matching filter, ordered by creation
+// time, along with a Cursor for the next page.
+func (s *SQLiteStore) QueryEvents(ctx context.Context, filter Filter) ([]ComplianceEvent, Cursor, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `SELECT id, country_code, merchant_id, transaction_id, event_type, regulator, outcome, detail, created_at
+		FROM compliance_events WHERE 1=1`
+	args := []interface{}{}
+
+	if filter.CountryCode != "" {
+		query += " AND country_code = ?"
+		args = append(args, filter.CountryCode)
+	}
+	if filter.MerchantID != "" {
+		query += " AND merchant_id = ?"
+		args = append(args, filter.MerchantID)
+	}
+	if !filter.From.IsZero() {
+		query += " AND created_at >= ?"
+		args = append(args, filter.From)
+	}
+	if !filter.To.IsZero() {
+		query += " AND created_at <= ?"
+		args = append(args, filter.To)
+	}
+	if filter.After != "" {
+		afterTime, afterID, err := decodeCursor(filter.After)
+		if err != nil {
+			return nil, "", err
+		}
+		query += " AND (created_at, id) > (?, ?)"
+		args = append(args, afterTime, afterID)
+	}
+
+	query += " ORDER BY created_at, id LIMIT ?"
+	args = append(args, limit+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("storage: querying compliance events: %w", err)
+	}
+	defer rows.Close()
+
+	var results []ComplianceEvent
+	for rows.Next() {
+		var e ComplianceEvent
+		if err := rows.Scan(&e.ID, &e.CountryCode, &e.MerchantID, &e.TransactionID, &e.EventType, &e.Regulator, &e.Outcome, &e.Detail, &e.CreatedAt); err != nil {
+			return nil, "", err
+		}
+		results = append(results, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var next Cursor
+	if len(results) > limit {
+		last := results[limit-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+		results = results[:limit]
+	}
+	return results, next, nil
+}
@@ -0,0 +1,160 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+func newTestSQLiteStore(t *testing.T) *SQLiteStore {
+	t.Helper()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	store, err := NewSQLiteStore(context.Background(), dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestSaveGetRoundTripPreservesAmount(t *testing.T) {
+	store := newTestSQLiteStore(t)
+
+	usd := money.MustGetCurrency("USD")
+	tx := Transaction{
+		ID:          "tx-1",
+		CountryCode: "SG",
+		MerchantID:  "merchant-1",
+		CustomerID:  "customer-1",
+		// $123.45, i.e. 12345 minor units (cents) for a 2-decimal currency.
+		Amount:         money.NewFromMinorInt64(usd, 12345),
+		CryptoCurrency: "BTC",
+		Status:         "completed",
+		CreatedAt:      time.Now().Truncate(time.Second),
+		UpdatedAt:      time.Now().Truncate(time.Second),
+	}
+
+	if err := store.Save(context.Background(), tx); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, err := store.Get(context.Background(), "tx-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if got.Amount.Currency().Code != usd.Code {
+		t.Fatalf("Get returned currency %q, want %q", got.Amount.Currency().Code, usd.Code)
+	}
+	if got.Amount.MinorUnits().Int64() != 12345 {
+		t.Fatalf("Get returned %s minor units, want 12345 (i.e. $123.45, not $12345.00)", got.Amount.MinorUnits())
+	}
+	if got.Amount.String() != "USD:123.45" {
+		t.Fatalf("Get returned amount %q, want \"USD:123.45\"", got.Amount.String())
+	}
+}
+
+func TestSaveGetRoundTripPreservesHighPrecisionCryptoAmount(t *testing.T) {
+	store := newTestSQLiteStore(t)
+
+	btc := money.MustGetCurrency("BTC")
+	// 1 satoshi short of 1 BTC, the kind of 8-decimal amount that would
+	// be off by 10^8 if the stored minor-units integer were ever
+	// re-parsed as a decimal string.
+	tx := Transaction{
+		ID:             "tx-2",
+		CountryCode:    "SG",
+		MerchantID:     "merchant-1",
+		CustomerID:     "customer-1",
+		Amount:         money.NewFromMinorInt64(btc, 99999999),
+		CryptoCurrency: "BTC",
+		Status:         "completed",
+		CreatedAt:      time.Now().Truncate(time.Second),
+		UpdatedAt:      time.Now().Truncate(time.Second),
+	}
+
+	if err := store.Save(context.Background(), tx); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, err := store.Get(context.Background(), "tx-2")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Amount.MinorUnits().Int64() != 99999999 {
+		t.Fatalf("Get returned %s minor units, want 99999999", got.Amount.MinorUnits())
+	}
+	if got.Amount.String() != "BTC:0.99999999" {
+		t.Fatalf("Get returned amount %q, want \"BTC:0.99999999\"", got.Amount.String())
+	}
+}
+
+func TestSaveUpsertsOnConflict(t *testing.T) {
+	store := newTestSQLiteStore(t)
+	usd := money.MustGetCurrency("USD")
+
+	tx := Transaction{
+		ID:          "tx-3",
+		CountryCode: "SG",
+		MerchantID:  "merchant-1",
+		Amount:      money.NewFromMinorInt64(usd, 1000),
+		Status:      "pending",
+		CreatedAt:   time.Now().Truncate(time.Second),
+		UpdatedAt:   time.Now().Truncate(time.Second),
+	}
+	if err := store.Save(context.Background(), tx); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	tx.Status = "completed"
+	tx.CompletedAt = time.Now().Truncate(time.Second)
+	tx.UpdatedAt = tx.CompletedAt
+	if err := store.Save(context.Background(), tx); err != nil {
+		t.Fatalf("Save (update): %v", err)
+	}
+
+	got, err := store.Get(context.Background(), "tx-3")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Status != "completed" {
+		t.Fatalf("Get returned status %q, want \"completed\"", got.Status)
+	}
+	if got.Amount.MinorUnits().Int64() != 1000 {
+		t.Fatalf("Get after update returned %s minor units, want 1000", got.Amount.MinorUnits())
+	}
+}
+
+func TestGetUnknownTransaction(t *testing.T) {
+	store := newTestSQLiteStore(t)
+	if _, err := store.Get(context.Background(), "does-not-exist"); err == nil {
+		t.Fatal("expected an error for an unknown transaction id")
+	}
+}
+
+func TestMigrationsCreateTransactionsTable(t *testing.T) {
+	store := newTestSQLiteStore(t)
+
+	// NewSQLiteStore already ran ApplyAll; if splitStatements ever
+	// merges the "-- +up" marker into CREATE TABLE transactions again,
+	// every query against a fresh database fails with "no such table".
+	if _, _, err := store.Query(context.Background(), Filter{CountryCode: "SG", Limit: 10}); err != nil {
+		t.Fatalf("Query against a freshly migrated database: %v", err)
+	}
+}
+
+func TestSplitStatementsStripsMarkerWithoutMergingFirstStatement(t *testing.T) {
+	stmts := splitStatements("-- +up\n\nCREATE TABLE foo (id INT);\nCREATE TABLE bar (id INT);\n")
+	if len(stmts) != 2 {
+		t.Fatalf("splitStatements returned %d statements, want 2: %q", len(stmts), stmts)
+	}
+	if stmts[0] != "CREATE TABLE foo (id INT)" {
+		t.Fatalf("splitStatements()[0] = %q, want \"CREATE TABLE foo (id INT)\"", stmts[0])
+	}
+	if stmts[1] != "CREATE TABLE bar (id INT)" {
+		t.Fatalf("splitStatements()[1] = %q, want \"CREATE TABLE bar (id INT)\"", stmts[1])
+	}
+}
@@ -0,0 +1,96 @@
+// Package storage defines the persistence layer for transactions and
+// compliance events, with SQLite and PostgreSQL backends driven by
+// versioned SQL migrations (see migrations.go).
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// Transaction is the canonical, storage-layer record of a payment
+// transaction. Country modules convert their own Transaction type into
+// this shape before persisting it.
+type Transaction struct {
+	ID                 string
+	CountryCode        string
+	MerchantID         string
+	CustomerID         string
+	Amount             money.Amount
+	CryptoCurrency     string
+	SourceAddress      string
+	DestinationAddress string
+	Status             string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	CompletedAt        time.Time
+}
+
+// ComplianceEvent records the outcome of a single compliance decision —
+// a ValidateTransaction call, a KYC record, or a regulator report
+// submission — for audit purposes.
+type ComplianceEvent struct {
+	ID            string
+	CountryCode   string
+	MerchantID    string
+	TransactionID string
+	EventType     string // e.g. "validate_transaction", "kyc_record", "report_generated"
+	Regulator     string
+	Outcome       string // e.g. "approved", "rejected", "generated"
+	Detail        string
+	CreatedAt     time.Time
+}
+
+// Cursor opaquely identifies a position within a paginated Query result.
+// An empty Cursor means "start from the beginning" when passed as
+// Filter.After, and "no more pages" when returned from Query.
+type Cursor string
+
+// Filter narrows a Query call against a TransactionStore or
+// ComplianceEventStore.
+type Filter struct {
+	CountryCode string
+	MerchantID  string
+	From        time.Time
+	To          time.Time
+	Limit       int
+	Order       string // "asc" (default) or "desc"
+	After       Cursor
+}
+
+// ReportQuery parameterizes a paginated, optionally long-polling query
+// against a TransactionStore, following pagination conventions similar
+// to Taler's PaginationParams: a cursor-based page with an optional
+// TimeoutMs that long-polls for at least one new record before
+// returning an empty page.
+type ReportQuery struct {
+	Start     time.Time
+	End       time.Time
+	Cursor    Cursor
+	Limit     int
+	Order     string // "asc" (default) or "desc"
+	TimeoutMs int    // if > 0, wait up to this many milliseconds for at least one result
+}
+
+// ReportPage is the result of a ReportQuery: a page of items (report
+// rows or transactions) plus pagination state.
+type ReportPage struct {
+	Items      []interface{}
+	NextCursor Cursor
+	HasMore    bool
+}
+
+// TransactionStore persists and queries payment transactions.
+type TransactionStore interface {
+	Save(ctx context.Context, tx Transaction) error
+	Get(ctx context.Context, id string) (Transaction, error)
+	Query(ctx context.Context, filter Filter) ([]Transaction, Cursor, error)
+}
+
+// ComplianceEventStore persists KYC decisions and regulator submissions.
+type ComplianceEventStore interface {
+	Record(ctx context.Context, event ComplianceEvent) error
+	QueryEvents(ctx context.Context, filter Filter) ([]ComplianceEvent, Cursor, error)
+}
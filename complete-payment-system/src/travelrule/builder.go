@@ -0,0 +1,26 @@
+package travelrule
+
+import (
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/compliance"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+)
+
+// GenerateMessage builds an IVMS 101 message for tx from the supplied
+// originator/beneficiary identity and the two VASPs' details. Real
+// deployments would source originator/beneficiary fields from KYC
+// records rather than have the caller pass them in directly; keeping
+// that lookup external makes it pluggable per country module.
+func GenerateMessage(tx *compliance.Transaction, originator, beneficiary ivms101.Person, originatingVASP, beneficiaryVASP ivms101.VASP) ivms101.Message {
+	return ivms101.Message{
+		Originator: ivms101.Originator{
+			OriginatorPersons: []ivms101.Person{originator},
+			AccountNumber:     tx.SourceAddress,
+		},
+		Beneficiary: ivms101.Beneficiary{
+			BeneficiaryPersons: []ivms101.Person{beneficiary},
+			AccountNumber:      tx.DestinationAddress,
+		},
+		OriginatingVASP: ivms101.OriginatingVASP{VASP: originatingVASP},
+		BeneficiaryVASP: ivms101.BeneficiaryVASP{VASP: beneficiaryVASP},
+	}
+}
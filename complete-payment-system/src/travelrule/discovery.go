@@ -0,0 +1,29 @@
+package travelrule
+
+import "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+
+// VASPDirectory resolves the beneficiary VASP responsible for a
+// destination wallet address, so a country module doesn't need to know
+// how counterparties are discovered (an on-chain registry lookup, a
+// bilateral allowlist, a TRISA directory, ...).
+type VASPDirectory interface {
+	Discover(destinationAddress string) (ivms101.CounterpartyVASP, bool)
+}
+
+// StaticDirectory is a VASPDirectory backed by a fixed address ->
+// counterparty map, suitable for bilateral integrations configured up
+// front.
+type StaticDirectory struct {
+	byAddress map[string]ivms101.CounterpartyVASP
+}
+
+// NewStaticDirectory creates a StaticDirectory from the given mapping.
+func NewStaticDirectory(byAddress map[string]ivms101.CounterpartyVASP) *StaticDirectory {
+	return &StaticDirectory{byAddress: byAddress}
+}
+
+// Discover implements VASPDirectory.
+func (d *StaticDirectory) Discover(destinationAddress string) (ivms101.CounterpartyVASP, bool) {
+	vasp, ok := d.byAddress[destinationAddress]
+	return vasp, ok
+}
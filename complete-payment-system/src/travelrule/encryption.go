@@ -0,0 +1,138 @@
+package travelrule
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+)
+
+// Encryptor is the subset of *security.SecurityService this package
+// needs to seal and sign a Travel Rule payload. It's declared locally
+// instead of importing package security, which shares the core/
+// directory with several unrelated packages (see core/main.go's
+// jwtAuthenticator for the same convention) — *security.SecurityService
+// already satisfies this interface structurally.
+type Encryptor interface {
+	EncryptData(data []byte, key []byte) (string, error)
+	DecryptData(encryptedData string, key []byte) ([]byte, error)
+	GenerateHMAC(data []byte, secret []byte) (string, error)
+	VerifyHMAC(data []byte, signature string, secret []byte) (bool, error)
+}
+
+// SealedMessage is an IVMS 101 message encrypted to a counterparty
+// VASP's static X25519 public key and signed with the sending VASP's
+// HMAC secret, suitable for transmission over an untrusted channel
+// without relying on transport-layer TLS alone.
+type SealedMessage struct {
+	// EphemeralPublicKey is the sender's one-time X25519 public key,
+	// base64-encoded. The recipient combines it with their own static
+	// private key via ECDH to rederive the shared secret Ciphertext
+	// was sealed under (the same ephemeral-key-per-message pattern
+	// ECIES uses).
+	EphemeralPublicKey string `json:"ephemeral_public_key"`
+
+	// Ciphertext is the IVMS 101 message AES-256-GCM sealed under the
+	// ECDH shared secret, as produced by Encryptor.EncryptData.
+	Ciphertext string `json:"ciphertext"`
+
+	// Signature is an HMAC over Ciphertext under a secret shared
+	// bilaterally between the two VASPs, letting the recipient
+	// authenticate who sent it — anyone holding the recipient's public
+	// key can encrypt a message to them, but only the holder of that
+	// shared secret can sign as the sending VASP. Like
+	// webhook.Client.Secret, this assumes one secret per counterparty
+	// relationship: reusing the same SenderSecret across multiple
+	// counterparties would let any of them forge messages to the
+	// others.
+	Signature string `json:"signature"`
+}
+
+// Seal encrypts msg to counterpartyPublicKey (the recipient VASP's
+// static X25519 public key) using a freshly generated ephemeral key
+// pair, then signs the ciphertext with senderSecret.
+func Seal(enc Encryptor, msg ivms101.Message, counterpartyPublicKey []byte, senderSecret []byte) (SealedMessage, error) {
+	payload, err := ivms101.EncodeIVMS101(msg)
+	if err != nil {
+		return SealedMessage{}, fmt.Errorf("travelrule: encoding message for encryption: %w", err)
+	}
+
+	recipientKey, err := ecdh.X25519().NewPublicKey(counterpartyPublicKey)
+	if err != nil {
+		return SealedMessage{}, fmt.Errorf("travelrule: invalid counterparty public key: %w", err)
+	}
+
+	ephemeralPriv, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return SealedMessage{}, fmt.Errorf("travelrule: generating ephemeral key pair: %w", err)
+	}
+
+	sharedSecret, err := ephemeralPriv.ECDH(recipientKey)
+	if err != nil {
+		return SealedMessage{}, fmt.Errorf("travelrule: deriving shared secret: %w", err)
+	}
+
+	ciphertext, err := enc.EncryptData(payload, sharedSecret)
+	if err != nil {
+		return SealedMessage{}, fmt.Errorf("travelrule: sealing message: %w", err)
+	}
+
+	signature, err := enc.GenerateHMAC([]byte(ciphertext), senderSecret)
+	if err != nil {
+		return SealedMessage{}, fmt.Errorf("travelrule: signing message: %w", err)
+	}
+
+	return SealedMessage{
+		EphemeralPublicKey: base64.StdEncoding.EncodeToString(ephemeralPriv.PublicKey().Bytes()),
+		Ciphertext:         ciphertext,
+		Signature:          signature,
+	}, nil
+}
+
+// Open verifies sealed's signature under senderSecret, then decrypts
+// it using recipientPrivateKey (this VASP's own static X25519 private
+// key), returning the original IVMS 101 message.
+func Open(enc Encryptor, sealed SealedMessage, recipientPrivateKey []byte, senderSecret []byte) (ivms101.Message, error) {
+	var msg ivms101.Message
+
+	verified, err := enc.VerifyHMAC([]byte(sealed.Ciphertext), sealed.Signature, senderSecret)
+	if err != nil {
+		return msg, fmt.Errorf("travelrule: verifying signature: %w", err)
+	}
+	if !verified {
+		return msg, fmt.Errorf("travelrule: sealed message signature verification failed")
+	}
+
+	ephemeralPublicKeyBytes, err := base64.StdEncoding.DecodeString(sealed.EphemeralPublicKey)
+	if err != nil {
+		return msg, fmt.Errorf("travelrule: decoding ephemeral public key: %w", err)
+	}
+	ephemeralPublicKey, err := ecdh.X25519().NewPublicKey(ephemeralPublicKeyBytes)
+	if err != nil {
+		return msg, fmt.Errorf("travelrule: invalid ephemeral public key: %w", err)
+	}
+
+	recipientKey, err := ecdh.X25519().NewPrivateKey(recipientPrivateKey)
+	if err != nil {
+		return msg, fmt.Errorf("travelrule: invalid recipient private key: %w", err)
+	}
+
+	sharedSecret, err := recipientKey.ECDH(ephemeralPublicKey)
+	if err != nil {
+		return msg, fmt.Errorf("travelrule: deriving shared secret: %w", err)
+	}
+
+	payload, err := enc.DecryptData(sealed.Ciphertext, sharedSecret)
+	if err != nil {
+		return msg, fmt.Errorf("travelrule: decrypting message: %w", err)
+	}
+
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		return msg, fmt.Errorf("travelrule: decoding decrypted message: %w", err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,39 @@
+package travelrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+)
+
+// Service dispatches Travel Rule messages for a country module: it
+// discovers the beneficiary VASP for a destination address via
+// Directory and transmits the message using Transport.
+type Service struct {
+	Directory VASPDirectory
+	Transport TransportProtocol
+}
+
+// NewService creates a Service using the synchronous HTTPS/JSON
+// transport by default.
+func NewService(directory VASPDirectory) *Service {
+	return &Service{Directory: directory, Transport: HTTPSJSONTransport{}}
+}
+
+// Send discovers the beneficiary VASP for destinationAddress and
+// transmits msg to it over s.Transport.
+func (s *Service) Send(ctx context.Context, destinationAddress string, msg ivms101.Message) error {
+	if s.Directory == nil {
+		return fmt.Errorf("travelrule: no VASP directory configured")
+	}
+	counterparty, ok := s.Directory.Discover(destinationAddress)
+	if !ok {
+		return fmt.Errorf("travelrule: no beneficiary VASP found for address %s", destinationAddress)
+	}
+
+	if s.Transport == nil {
+		return fmt.Errorf("travelrule: no transport configured")
+	}
+	return s.Transport.Send(ctx, counterparty, msg)
+}
@@ -0,0 +1,127 @@
+package travelrule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+)
+
+// TransportProtocol sends an IVMS 101 message to a counterparty VASP
+// using a specific inter-VASP messaging protocol.
+type TransportProtocol interface {
+	Name() string
+	Send(ctx context.Context, counterparty ivms101.CounterpartyVASP, msg ivms101.Message) error
+}
+
+// HTTPSJSONTransport posts the message as JSON in a single synchronous
+// request — the simplest bilateral integration style, and the default
+// used when no TRP/TRISA relationship has been configured.
+type HTTPSJSONTransport struct{}
+
+// Name implements TransportProtocol.
+func (HTTPSJSONTransport) Name() string { return "https-json" }
+
+// Send implements TransportProtocol.
+func (HTTPSJSONTransport) Send(ctx context.Context, counterparty ivms101.CounterpartyVASP, msg ivms101.Message) error {
+	return ivms101.TransmitTravelRule(ctx, counterparty, msg)
+}
+
+// TRPClient is the subset of a Travel Rule Protocol endpoint integrators
+// must implement. TRPTransport defers entirely to it so a real TRP or
+// TRISA client can be plugged in without changing country module code.
+type TRPClient interface {
+	// Request sends the proposed transfer to the counterparty and
+	// reports whether they approved it.
+	Request(ctx context.Context, counterparty ivms101.CounterpartyVASP, msg ivms101.Message) (approved bool, err error)
+	// Confirm finalizes an approved transfer with the counterparty.
+	Confirm(ctx context.Context, counterparty ivms101.CounterpartyVASP, msg ivms101.Message) error
+}
+
+// TRPTransport implements the Travel Rule Protocol's three-message
+// request/approve/confirm exchange: the originating VASP sends a
+// request, the beneficiary VASP approves or rejects it, and the
+// originating VASP confirms before releasing funds.
+type TRPTransport struct {
+	Client TRPClient
+}
+
+// Name implements TransportProtocol.
+func (t TRPTransport) Name() string { return "trp" }
+
+// Send implements TransportProtocol.
+func (t TRPTransport) Send(ctx context.Context, counterparty ivms101.CounterpartyVASP, msg ivms101.Message) error {
+	if t.Client == nil {
+		return fmt.Errorf("travelrule: no TRP client configured for %s", counterparty.Name)
+	}
+
+	approved, err := t.Client.Request(ctx, counterparty, msg)
+	if err != nil {
+		return fmt.Errorf("travelrule: TRP request to %s: %w", counterparty.Name, err)
+	}
+	if !approved {
+		return fmt.Errorf("travelrule: counterparty %s did not approve the transfer", counterparty.Name)
+	}
+
+	return t.Client.Confirm(ctx, counterparty, msg)
+}
+
+// EncryptedHTTPTransport is HTTPSJSONTransport's encrypted counterpart:
+// instead of POSTing the IVMS 101 message in the clear, it seals it to
+// the counterparty's published X25519 public key and signs it with
+// SenderSecret (see Seal) before sending the resulting SealedMessage
+// as JSON. Use it once a counterparty has published an encryption
+// public key; fall back to HTTPSJSONTransport (or a TRP/TRISA
+// transport) for counterparties that haven't.
+type EncryptedHTTPTransport struct {
+	Encryptor    Encryptor
+	SenderSecret []byte
+	HTTPClient   *http.Client
+}
+
+// Name implements TransportProtocol.
+func (t EncryptedHTTPTransport) Name() string { return "encrypted-https-json" }
+
+// Send implements TransportProtocol.
+func (t EncryptedHTTPTransport) Send(ctx context.Context, counterparty ivms101.CounterpartyVASP, msg ivms101.Message) error {
+	if t.Encryptor == nil {
+		return fmt.Errorf("travelrule: no encryptor configured for %s", counterparty.Name)
+	}
+	if len(counterparty.PublicKey) == 0 {
+		return fmt.Errorf("travelrule: counterparty %s has no published encryption public key", counterparty.Name)
+	}
+
+	sealed, err := Seal(t.Encryptor, msg, counterparty.PublicKey, t.SenderSecret)
+	if err != nil {
+		return fmt.Errorf("travelrule: sealing message for %s: %w", counterparty.Name, err)
+	}
+
+	body, err := json.Marshal(sealed)
+	if err != nil {
+		return fmt.Errorf("travelrule: encoding sealed message for %s: %w", counterparty.Name, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, counterparty.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("travelrule: building request to %s: %w", counterparty.Name, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := t.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("travelrule: transmitting sealed message to %s: %w", counterparty.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("travelrule: counterparty %s rejected sealed travel rule message: %s", counterparty.Name, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Package travelrule is the cross-cutting FATF Travel Rule (FATF
+// Recommendation 16) subsystem shared by every country module: IVMS 101
+// message construction, per-country threshold configuration, field
+// validation, beneficiary-VASP discovery, and pluggable inter-VASP
+// transports. Country modules call into this package instead of
+// hand-rolling their own map-shaped Travel Rule payloads.
+package travelrule
+
+import (
+	"sync"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/money"
+)
+
+// TransferPath records the hop-by-hop VASP path a transfer took. It is
+// an informal extension some jurisdictions require alongside the core
+// IVMS 101 Originator/Beneficiary groups when a transfer passes through
+// an intermediary VASP.
+type TransferPath struct {
+	OriginatingVASPIdentifier   string
+	BeneficiaryVASPIdentifier   string
+	IntermediaryVASPIdentifiers []string
+}
+
+var (
+	thresholdMu sync.RWMutex
+	thresholds  = map[string]money.Amount{}
+)
+
+// SetThreshold overrides the Travel Rule threshold for countryCode,
+// letting operators respond to a regulatory change without a code
+// deploy.
+func SetThreshold(countryCode string, amount money.Amount) {
+	thresholdMu.Lock()
+	defer thresholdMu.Unlock()
+	thresholds[countryCode] = amount
+}
+
+// ThresholdFor returns the configured Travel Rule threshold for
+// countryCode, or fallback if none has been set via SetThreshold.
+func ThresholdFor(countryCode string, fallback money.Amount) money.Amount {
+	thresholdMu.RLock()
+	defer thresholdMu.RUnlock()
+	if amount, ok := thresholds[countryCode]; ok {
+		return amount
+	}
+	return fallback
+}
+
+func init() {
+	SetThreshold("SG", money.NewFromMinorInt64(money.MustGetCurrency("SGD"), 150000)) // SGD 1,500
+}
@@ -0,0 +1,39 @@
+package travelrule
+
+import (
+	"errors"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/ivms101"
+)
+
+// ValidateMessage checks that m carries the minimum IVMS 101 fields FATF
+// Recommendation 16 requires before a VASP may execute a transfer above
+// the jurisdiction's threshold.
+func ValidateMessage(m ivms101.Message) error {
+	if len(m.Originator.OriginatorPersons) == 0 {
+		return errors.New("travelrule: message is missing originator information")
+	}
+	for _, p := range m.Originator.OriginatorPersons {
+		if p.NaturalPerson == nil && p.LegalPerson == nil {
+			return errors.New("travelrule: originator person is missing natural/legal person details")
+		}
+	}
+
+	if len(m.Beneficiary.BeneficiaryPersons) == 0 {
+		return errors.New("travelrule: message is missing beneficiary information")
+	}
+	for _, p := range m.Beneficiary.BeneficiaryPersons {
+		if p.NaturalPerson == nil && p.LegalPerson == nil {
+			return errors.New("travelrule: beneficiary person is missing natural/legal person details")
+		}
+	}
+
+	if m.OriginatingVASP.VASP.VASPIdentifier == "" {
+		return errors.New("travelrule: message is missing the originating VASP identifier")
+	}
+	if m.BeneficiaryVASP.VASP.VASPIdentifier == "" {
+		return errors.New("travelrule: message is missing the beneficiary VASP identifier")
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+// Package keystore is the reference signer.SignerBackend implementation,
+// wrapping this system's existing Web3 keystore (wallet/keystore) so
+// WalletService can route a wallet through the signer abstraction even
+// when its key is just a local encrypted file rather than a hardware
+// device. Unlike backends/ledger and backends/pkcs11, it cannot
+// self-register at init time — it needs a concrete walletkeystore.KeyStore
+// injected, and each of its accounts must be explicitly associated with
+// a derivation path via Register, since there is no local-file
+// equivalent of a hardware wallet enumerating its own accounts.
+package keystore
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+
+	walletkeystore "github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/keystore"
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/signer"
+)
+
+// BackendID is the identifier a Backend should be registered under
+// (e.g. signer.Register(keystore.BackendID, keystore.New(ks))).
+const BackendID = "keystore"
+
+// unlockTimeout is how long Open caches every registered account's
+// decrypted key, mirroring wallet.defaultUnlockTimeout's role for
+// WalletService.UnlockWallet.
+const unlockTimeout = 5 * time.Minute
+
+// accountEntry pairs an AccountRef with the underlying keystore's
+// walletID, since signer.AccountRef has no room for this backend's own
+// bookkeeping key.
+type accountEntry struct {
+	walletID string
+	ref      signer.AccountRef
+}
+
+// Backend is a signer.SignerBackend over a walletkeystore.KeyStore.
+type Backend struct {
+	mu       sync.Mutex
+	keystore walletkeystore.KeyStore
+	accounts map[signer.DerivationPath]accountEntry
+}
+
+// New creates a Backend over ks. It starts out with no accounts — call
+// Register for each wallet it should expose before registering the
+// Backend itself (see signer.Register).
+func New(ks walletkeystore.KeyStore) *Backend {
+	return &Backend{keystore: ks, accounts: make(map[signer.DerivationPath]accountEntry)}
+}
+
+// Register associates path with walletID, address, and pubKey, so
+// subsequent Derive/List/SignHash calls for path resolve against
+// walletID's entry in the underlying keystore.
+func (b *Backend) Register(walletID, address string, pubKey []byte, path signer.DerivationPath) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.accounts[path] = accountEntry{
+		walletID: walletID,
+		ref:      signer.AccountRef{BackendID: BackendID, Path: path, Address: address, PublicKey: pubKey},
+	}
+}
+
+// List returns every account Register has been called for.
+func (b *Backend) List() ([]signer.AccountRef, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	refs := make([]signer.AccountRef, 0, len(b.accounts))
+	for _, entry := range b.accounts {
+		refs = append(refs, entry.ref)
+	}
+	return refs, nil
+}
+
+// Derive returns the AccountRef Register associated with path.
+func (b *Backend) Derive(path signer.DerivationPath) (signer.AccountRef, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entry, ok := b.accounts[path]
+	if !ok {
+		return signer.AccountRef{}, fmt.Errorf("keystore backend: no account registered at path %s", path)
+	}
+	return entry.ref, nil
+}
+
+// Open unlocks every registered account's underlying keystore entry
+// under passphrase, so SignHash/SignTx can sign without the passphrase
+// being supplied again. If any account fails to unlock (e.g. the
+// accounts registered on this Backend don't all share one passphrase),
+// Open rolls back every account it had already unlocked in this call
+// and returns the error, rather than leaving a partially-opened Backend
+// whose caller believes Open failed.
+func (b *Backend) Open(passphrase string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	opened := make([]string, 0, len(b.accounts))
+	for _, entry := range b.accounts {
+		if err := b.keystore.Unlock(entry.walletID, passphrase, unlockTimeout); err != nil {
+			for _, walletID := range opened {
+				b.keystore.Lock(walletID)
+			}
+			return err
+		}
+		opened = append(opened, entry.walletID)
+	}
+	return nil
+}
+
+// Close immediately wipes every registered account's cached key.
+func (b *Backend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, entry := range b.accounts {
+		if err := b.keystore.Lock(entry.walletID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignHash signs hash with acct's private key, which must already be
+// unlocked via Open.
+func (b *Backend) SignHash(acct signer.AccountRef, hash []byte) ([]byte, error) {
+	b.mu.Lock()
+	entry, ok := b.accounts[acct.Path]
+	b.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("keystore backend: no account registered at path %s", acct.Path)
+	}
+
+	keyBytes, ok := b.keystore.UnlockedKey(entry.walletID)
+	if !ok {
+		return nil, signer.ErrBackendLocked
+	}
+	priv, _ := btcec.PrivKeyFromBytes(keyBytes)
+	signature := ecdsa.Sign(priv, hash)
+	return signature.Serialize(), nil
+}
+
+// SignTx hashes rawTx with sha256 and signs it via SignHash. chainID is
+// accepted only for SignerBackend interface compatibility and ignored:
+// this system's own transaction format carries no chain-ID replay
+// protection at the signing step (see wallet.FinalizeAndBroadcast).
+func (b *Backend) SignTx(acct signer.AccountRef, rawTx []byte, chainID *big.Int) ([]byte, error) {
+	sigHash := sha256.Sum256(rawTx)
+	return b.SignHash(acct, sigHash[:])
+}
@@ -0,0 +1,50 @@
+// Package ledger is a placeholder signer.SignerBackend for a Ledger
+// hardware wallet (BTC/ETH apps over USB HID). It registers itself at
+// init time, as a real device-backed implementation would, but every
+// method currently returns ErrNotImplemented.
+package ledger
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/signer"
+)
+
+// BackendID is the identifier this backend registers itself under.
+const BackendID = "ledger"
+
+// ErrNotImplemented is returned by every Backend method: USB HID
+// enumeration and the Ledger BTC/ETH app protocols aren't wired up yet.
+var ErrNotImplemented = errors.New("ledger: not implemented")
+
+// Backend is an unimplemented signer.SignerBackend stub.
+type Backend struct{}
+
+func init() {
+	signer.Register(BackendID, Backend{})
+}
+
+func (Backend) List() ([]signer.AccountRef, error) {
+	return nil, ErrNotImplemented
+}
+
+func (Backend) Derive(path signer.DerivationPath) (signer.AccountRef, error) {
+	return signer.AccountRef{}, ErrNotImplemented
+}
+
+func (Backend) SignHash(acct signer.AccountRef, hash []byte) ([]byte, error) {
+	return nil, ErrNotImplemented
+}
+
+func (Backend) SignTx(acct signer.AccountRef, rawTx []byte, chainID *big.Int) ([]byte, error) {
+	return nil, ErrNotImplemented
+}
+
+func (Backend) Open(passphrase string) error {
+	return ErrNotImplemented
+}
+
+func (Backend) Close() error {
+	return nil
+}
@@ -0,0 +1,51 @@
+// Package pkcs11 is a placeholder signer.SignerBackend for a PKCS#11
+// HSM or smart card. It registers itself at init time, as a real
+// module-backed implementation would, but every method currently
+// returns ErrNotImplemented.
+package pkcs11
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/wallet/signer"
+)
+
+// BackendID is the identifier this backend registers itself under.
+const BackendID = "pkcs11"
+
+// ErrNotImplemented is returned by every Backend method: loading a
+// PKCS#11 module and opening a session against its slots isn't wired
+// up yet.
+var ErrNotImplemented = errors.New("pkcs11: not implemented")
+
+// Backend is an unimplemented signer.SignerBackend stub.
+type Backend struct{}
+
+func init() {
+	signer.Register(BackendID, Backend{})
+}
+
+func (Backend) List() ([]signer.AccountRef, error) {
+	return nil, ErrNotImplemented
+}
+
+func (Backend) Derive(path signer.DerivationPath) (signer.AccountRef, error) {
+	return signer.AccountRef{}, ErrNotImplemented
+}
+
+func (Backend) SignHash(acct signer.AccountRef, hash []byte) ([]byte, error) {
+	return nil, ErrNotImplemented
+}
+
+func (Backend) SignTx(acct signer.AccountRef, rawTx []byte, chainID *big.Int) ([]byte, error) {
+	return nil, ErrNotImplemented
+}
+
+func (Backend) Open(passphrase string) error {
+	return ErrNotImplemented
+}
+
+func (Backend) Close() error {
+	return nil
+}
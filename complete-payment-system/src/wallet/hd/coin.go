@@ -0,0 +1,105 @@
+package hd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"golang.org/x/crypto/sha3"
+)
+
+// Coin maps one of this system's currency codes (see money.Currency) to
+// its BIP-44 coin type and the function that turns a derived public key
+// into that chain's address string.
+type Coin struct {
+	// Type is the BIP-44 coin_type used in m/44'/coin_type'/... — see
+	// https://github.com/satoshilabs/slips/blob/master/slip-0044.md.
+	Type uint32
+
+	// Name documents which chain Type and Encode actually implement,
+	// for currencies (like USDT, BNB below) whose coin type is a
+	// judgment call rather than a literal SLIP-44 lookup.
+	Name string
+
+	// Encode derives the chain's address string from a receive
+	// public key.
+	Encode func(pub *btcec.PublicKey) (string, error)
+}
+
+var (
+	coinsMu sync.RWMutex
+	coins   = map[string]Coin{}
+)
+
+// RegisterCoin registers (or replaces) the Coin used for currency.
+func RegisterCoin(currency string, coin Coin) {
+	coinsMu.Lock()
+	defer coinsMu.Unlock()
+	coins[currency] = coin
+}
+
+// CoinFor looks up the Coin registered for currency.
+func CoinFor(currency string) (Coin, error) {
+	coinsMu.RLock()
+	defer coinsMu.RUnlock()
+	coin, ok := coins[currency]
+	if !ok {
+		return Coin{}, fmt.Errorf("hd: no coin registered for currency %q", currency)
+	}
+	return coin, nil
+}
+
+// RegisteredCurrencies returns the currency codes with a Coin
+// registered, in no particular order. RecoverFromMnemonic uses this to
+// run gap-limit discovery against every chain a mnemonic could hold
+// funds on, not just one.
+func RegisteredCurrencies() []string {
+	coinsMu.RLock()
+	defer coinsMu.RUnlock()
+	currencies := make([]string, 0, len(coins))
+	for currency := range coins {
+		currencies = append(currencies, currency)
+	}
+	return currencies
+}
+
+func init() {
+	RegisterCoin("BTC", Coin{Type: 0, Name: "Bitcoin", Encode: encodeP2PKH})
+	RegisterCoin("ETH", Coin{Type: 60, Name: "Ethereum", Encode: encodeEthereumStyle})
+
+	// USDT, as this system handles it, is an ERC-20 token on Ethereum
+	// (see money.GetCurrency's Decimals:6 alongside ETH's Decimals:18)
+	// rather than a chain of its own — SLIP-44 has no separate entry
+	// for an asset-layer token, so it shares ETH's coin type and
+	// address format.
+	RegisterCoin("USDT", Coin{Type: 60, Name: "Ethereum (ERC-20 USDT)", Encode: encodeEthereumStyle})
+
+	// SLIP-44 registers coin_type 714 for the BNB Beacon Chain's
+	// native asset, with its own bech32 address format. This system's
+	// BNB is BEP-20 on BNB Smart Chain — an EVM-compatible chain — so
+	// it's derived and addressed exactly like Ethereum (coin type 60),
+	// the same judgment call most EVM HD wallets make for BSC today.
+	RegisterCoin("BNB", Coin{Type: 60, Name: "BNB Smart Chain (BEP-20)", Encode: encodeEthereumStyle})
+}
+
+// encodeP2PKH derives a mainnet Bitcoin P2PKH address (the classic
+// 1... address) from pub: base58check(version 0x00 || HASH160(pub)).
+func encodeP2PKH(pub *btcec.PublicKey) (string, error) {
+	h160 := btcutil.Hash160(pub.SerializeCompressed())
+	payload := append([]byte{0x00}, h160...)
+	return base58.Encode(append(payload, checksum(payload)...)), nil
+}
+
+// encodeEthereumStyle derives a 0x-prefixed Ethereum-style address from
+// pub: the last 20 bytes of Keccak-256(uncompressed pubkey, minus the
+// leading 0x04 prefix byte).
+func encodeEthereumStyle(pub *btcec.PublicKey) (string, error) {
+	uncompressed := pub.SerializeUncompressed()
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(uncompressed[1:])
+	digest := hash.Sum(nil)
+	return "0x" + hex.EncodeToString(digest[12:]), nil
+}
@@ -0,0 +1,254 @@
+package hd
+
+import (
+	"bytes"
+	"testing"
+)
+
+func testSeed(t *testing.T) []byte {
+	t.Helper()
+	seed, err := NewSeed()
+	if err != nil {
+		t.Fatalf("NewSeed: %v", err)
+	}
+	return seed[:]
+}
+
+func TestNewMasterKey_RejectsShortAndLongSeeds(t *testing.T) {
+	if _, err := NewMasterKey(make([]byte, 15), MainNet); err == nil {
+		t.Fatal("expected error for a 15-byte seed")
+	}
+	if _, err := NewMasterKey(make([]byte, 65), MainNet); err == nil {
+		t.Fatal("expected error for a 65-byte seed")
+	}
+	if _, err := NewMasterKey(make([]byte, 32), MainNet); err != nil {
+		t.Fatalf("expected a 32-byte seed to be accepted: %v", err)
+	}
+}
+
+func TestNewMasterKey_Deterministic(t *testing.T) {
+	seed := testSeed(t)
+	k1, err := NewMasterKey(seed, MainNet)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	k2, err := NewMasterKey(seed, MainNet)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	if k1.String() != k2.String() {
+		t.Fatalf("same seed produced different master keys:\n%s\n%s", k1.String(), k2.String())
+	}
+}
+
+func TestDerive_Deterministic(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t), MainNet)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	c1, err := master.Derive(0)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	c2, err := master.Derive(0)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	if c1.String() != c2.String() {
+		t.Fatal("deriving the same index twice produced different children")
+	}
+
+	other, err := master.Derive(1)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	if c1.String() == other.String() {
+		t.Fatal("deriving different indexes produced identical children")
+	}
+}
+
+func TestDerive_HardenedRequiresPrivateParent(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t), MainNet)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	pub := master.Neuter()
+	if pub.IsPrivate() {
+		t.Fatal("Neuter did not strip the private key")
+	}
+	if _, err := pub.Derive(hardenedOffset); err == nil {
+		t.Fatal("expected an error deriving a hardened child from a public-only key")
+	}
+	if _, err := pub.Derive(0); err != nil {
+		t.Fatalf("expected a non-hardened child to derive from a public-only key: %v", err)
+	}
+}
+
+func TestDerive_PublicMatchesNeuteredPrivate(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t), MainNet)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+
+	privChild, err := master.Derive(7)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	pubChild, err := master.Neuter().Derive(7)
+	if err != nil {
+		t.Fatalf("Derive on neutered parent: %v", err)
+	}
+
+	if !bytes.Equal(privChild.PublicKey.SerializeCompressed(), pubChild.PublicKey.SerializeCompressed()) {
+		t.Fatal("CKDpriv and CKDpub disagree on the derived public key for a non-hardened index")
+	}
+	if privChild.ChainCode != pubChild.ChainCode {
+		t.Fatal("CKDpriv and CKDpub disagree on the derived chain code")
+	}
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t), MainNet)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	child, err := master.Derive(hardenedOffset + 44)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+
+	for _, k := range []*ExtendedKey{master, child, child.Neuter()} {
+		encoded := k.String()
+		decoded, err := ParseExtendedKey(encoded)
+		if err != nil {
+			t.Fatalf("ParseExtendedKey(%q): %v", encoded, err)
+		}
+		if decoded.String() != encoded {
+			t.Fatalf("round trip changed the serialized key: got %q, want %q", decoded.String(), encoded)
+		}
+		if decoded.IsPrivate() != k.IsPrivate() {
+			t.Fatalf("round trip changed IsPrivate: got %v, want %v", decoded.IsPrivate(), k.IsPrivate())
+		}
+	}
+}
+
+func TestParseExtendedKey_RejectsBadChecksum(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t), MainNet)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	encoded := []byte(master.String())
+	// Flip the last character so the base58check checksum no longer matches.
+	if encoded[len(encoded)-1] == 'a' {
+		encoded[len(encoded)-1] = 'b'
+	} else {
+		encoded[len(encoded)-1] = 'a'
+	}
+	if _, err := ParseExtendedKey(string(encoded)); err == nil {
+		t.Fatal("expected a checksum error for a corrupted extended key")
+	}
+}
+
+func TestDeriveAccountAndAddress(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t), MainNet)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	btc, err := CoinFor("BTC")
+	if err != nil {
+		t.Fatalf("CoinFor(BTC): %v", err)
+	}
+	eth, err := CoinFor("ETH")
+	if err != nil {
+		t.Fatalf("CoinFor(ETH): %v", err)
+	}
+
+	btcAccount, err := DeriveAccount(master, btc, 0)
+	if err != nil {
+		t.Fatalf("DeriveAccount(BTC): %v", err)
+	}
+	ethAccount, err := DeriveAccount(master, eth, 0)
+	if err != nil {
+		t.Fatalf("DeriveAccount(ETH): %v", err)
+	}
+	if btcAccount.String() == ethAccount.String() {
+		t.Fatal("different coin types produced the same account key")
+	}
+
+	addr0, err := DeriveAddress(btcAccount, 0, 0)
+	if err != nil {
+		t.Fatalf("DeriveAddress(0,0): %v", err)
+	}
+	addr1, err := DeriveAddress(btcAccount, 0, 1)
+	if err != nil {
+		t.Fatalf("DeriveAddress(0,1): %v", err)
+	}
+	if addr0.String() == addr1.String() {
+		t.Fatal("different address indexes produced the same key")
+	}
+
+	encoded, err := btc.Encode(addr0.PublicKey)
+	if err != nil {
+		t.Fatalf("btc.Encode: %v", err)
+	}
+	if encoded == "" {
+		t.Fatal("expected a non-empty BTC address")
+	}
+}
+
+func TestCoinRegistry(t *testing.T) {
+	for _, currency := range []string{"BTC", "ETH", "USDT", "BNB"} {
+		if _, err := CoinFor(currency); err != nil {
+			t.Errorf("CoinFor(%s): %v", currency, err)
+		}
+	}
+	if _, err := CoinFor("NOPE"); err == nil {
+		t.Fatal("expected an error for an unregistered currency")
+	}
+
+	registered := RegisteredCurrencies()
+	found := false
+	for _, c := range registered {
+		if c == "BTC" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("RegisteredCurrencies() = %v, want it to include BTC", registered)
+	}
+}
+
+func TestMnemonicRoundTrip(t *testing.T) {
+	seed, err := NewSeed()
+	if err != nil {
+		t.Fatalf("NewSeed: %v", err)
+	}
+	mnemonic, err := NewMnemonic(seed)
+	if err != nil {
+		t.Fatalf("NewMnemonic: %v", err)
+	}
+
+	stretched1, err := SeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		t.Fatalf("SeedFromMnemonic: %v", err)
+	}
+	stretched2, err := SeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		t.Fatalf("SeedFromMnemonic: %v", err)
+	}
+	if !bytes.Equal(stretched1, stretched2) {
+		t.Fatal("stretching the same mnemonic twice produced different seeds")
+	}
+
+	stretchedWithPassphrase, err := SeedFromMnemonic(mnemonic, "a passphrase")
+	if err != nil {
+		t.Fatalf("SeedFromMnemonic with passphrase: %v", err)
+	}
+	if bytes.Equal(stretched1, stretchedWithPassphrase) {
+		t.Fatal("a different passphrase produced the same stretched seed")
+	}
+
+	if _, err := SeedFromMnemonic("not a valid mnemonic", ""); err == nil {
+		t.Fatal("expected an error for an invalid mnemonic")
+	}
+}
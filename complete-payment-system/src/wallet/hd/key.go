@@ -0,0 +1,241 @@
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcutil"
+)
+
+// hardenedOffset is added to a child index to mark it hardened (the
+// conventional index' notation), per BIP-32.
+const hardenedOffset = uint32(0x80000000)
+
+// maxDerivationRetries bounds the "IL >= n or child == 0, try the next
+// index" retry loop BIP-32 specifies. A real collision is vanishingly
+// improbable (on the order of 1 in 2^127); the cap exists only so a
+// broken curve implementation fails loudly instead of spinning forever.
+const maxDerivationRetries = 1024
+
+// IsHardened reports whether index falls in the hardened range (≥
+// 2^31, written index' in path notation).
+func IsHardened(index uint32) bool { return index >= hardenedOffset }
+
+// Network selects the version bytes String uses when serializing to
+// xprv/xpub, so keys from different networks are never confused for
+// one another (and a testnet key never decodes as a mainnet one).
+type Network struct {
+	Name           string
+	PrivateVersion uint32
+	PublicVersion  uint32
+}
+
+// MainNet and TestNet are the version bytes Bitcoin Core itself uses
+// for xprv/xpub (mainnet) and tprv/tpub (testnet). Ethereum and other
+// secp256k1 chains derived through this package reuse MainNet — BIP-32
+// serialization is chain-agnostic, only the Coin's address encoding
+// differs.
+var (
+	MainNet = Network{Name: "mainnet", PrivateVersion: 0x0488ADE4, PublicVersion: 0x0488B21E}
+	TestNet = Network{Name: "testnet", PrivateVersion: 0x04358394, PublicVersion: 0x043587CF}
+)
+
+// ExtendedKey is a single BIP-32 node. PrivateKey is nil on a
+// public-only key (produced by Neuter), which can still derive further
+// public children but never a hardened child or a private key.
+type ExtendedKey struct {
+	Network    Network
+	Depth      uint8
+	ParentFP   [4]byte
+	ChildIndex uint32
+	ChainCode  [32]byte
+	PrivateKey *btcec.PrivateKey
+	PublicKey  *btcec.PublicKey
+}
+
+// NewMasterKey derives the master ExtendedKey for seed — the 64-byte
+// BIP-39 seed returned by SeedFromMnemonic, or any other high-entropy
+// byte slice between 16 and 64 bytes — following BIP-32: I =
+// HMAC-SHA512("Bitcoin seed", seed); the left 32 bytes become the
+// master private key, the right 32 the master chain code.
+func NewMasterKey(seed []byte, network Network) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, fmt.Errorf("hd: seed must be 16-64 bytes, got %d", len(seed))
+	}
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	I := mac.Sum(nil)
+	il, ir := I[:32], I[32:]
+
+	if !validPrivateScalar(il) {
+		return nil, fmt.Errorf("hd: seed produced an invalid master key, generate a new seed")
+	}
+	priv, pub := btcec.PrivKeyFromBytes(il)
+
+	var chainCode [32]byte
+	copy(chainCode[:], ir)
+
+	return &ExtendedKey{
+		Network:    network,
+		ChainCode:  chainCode,
+		PrivateKey: priv,
+		PublicKey:  pub,
+	}, nil
+}
+
+// Neuter strips k's private key, producing the public-only extended
+// key (an xpub) a WalletTypeCold wallet can hand to a watch-only
+// balance-sync process without ever exposing spend authority.
+func (k *ExtendedKey) Neuter() *ExtendedKey {
+	return &ExtendedKey{
+		Network:    k.Network,
+		Depth:      k.Depth,
+		ParentFP:   k.ParentFP,
+		ChildIndex: k.ChildIndex,
+		ChainCode:  k.ChainCode,
+		PublicKey:  k.PublicKey,
+	}
+}
+
+// IsPrivate reports whether k carries a private key.
+func (k *ExtendedKey) IsPrivate() bool { return k.PrivateKey != nil }
+
+// Derive returns k's child at index, implementing BIP-32 CKDpriv (when
+// k is private) or CKDpub (when k is public-only). Hardened derivation
+// (index >= 2^31) requires a private parent.
+//
+// Per BIP-32, if the HMAC output's left half parses to a scalar >= the
+// curve order, or the resulting child key is the point at infinity /
+// zero, that index is invalid and derivation retries at index+1 — see
+// maxDerivationRetries.
+func (k *ExtendedKey) Derive(index uint32) (*ExtendedKey, error) {
+	if IsHardened(index) && !k.IsPrivate() {
+		return nil, fmt.Errorf("hd: cannot derive hardened child %d from a public-only key", index)
+	}
+
+	for attempt := 0; attempt < maxDerivationRetries; attempt++ {
+		child, ok, err := k.deriveOnce(index)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return child, nil
+		}
+		index++ // BIP-32: skip to the next index on an invalid derivation
+	}
+	return nil, fmt.Errorf("hd: could not derive a valid child within %d attempts starting at index %d", maxDerivationRetries, index)
+}
+
+// deriveOnce attempts a single BIP-32 CKD step at index, returning
+// ok=false (not an error) when BIP-32 says to retry at the next index.
+func (k *ExtendedKey) deriveOnce(index uint32) (child *ExtendedKey, ok bool, err error) {
+	var data []byte
+	if IsHardened(index) {
+		// data = 0x00 || ser256(parent private key) || ser32(index)
+		data = make([]byte, 0, 37)
+		data = append(data, 0x00)
+		data = append(data, k.PrivateKey.Serialize()...)
+	} else {
+		// data = serP(parent public key) || ser32(index)
+		data = make([]byte, 0, 37)
+		data = append(data, k.PublicKey.SerializeCompressed()...)
+	}
+	var idxBytes [4]byte
+	binary.BigEndian.PutUint32(idxBytes[:], index)
+	data = append(data, idxBytes[:]...)
+
+	mac := hmac.New(sha512.New, k.ChainCode[:])
+	mac.Write(data)
+	I := mac.Sum(nil)
+	il, ir := I[:32], I[32:]
+
+	if !validPrivateScalar(il) {
+		return nil, false, nil
+	}
+
+	var childChainCode [32]byte
+	copy(childChainCode[:], ir)
+	parentFP := fingerprint(k.PublicKey)
+
+	if k.IsPrivate() {
+		childScalar := new(big.Int).Add(
+			new(big.Int).SetBytes(il),
+			new(big.Int).SetBytes(k.PrivateKey.Serialize()),
+		)
+		childScalar.Mod(childScalar, btcec.S256().N)
+		if childScalar.Sign() == 0 {
+			return nil, false, nil
+		}
+
+		childPrivBytes := make([]byte, 32)
+		childScalar.FillBytes(childPrivBytes)
+		childPriv, childPub := btcec.PrivKeyFromBytes(childPrivBytes)
+
+		return &ExtendedKey{
+			Network:    k.Network,
+			Depth:      k.Depth + 1,
+			ParentFP:   parentFP,
+			ChildIndex: index,
+			ChainCode:  childChainCode,
+			PrivateKey: childPriv,
+			PublicKey:  childPub,
+		}, true, nil
+	}
+
+	// Public-only (xpub) derivation: child point = IL*G + parent point.
+	curve := btcec.S256()
+	ilX, ilY := curve.ScalarBaseMult(il)
+	childX, childY := curve.Add(ilX, ilY, k.PublicKey.X(), k.PublicKey.Y())
+	if childX.Sign() == 0 && childY.Sign() == 0 {
+		return nil, false, nil
+	}
+	childPub, err := publicKeyFromCoords(childX, childY)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &ExtendedKey{
+		Network:    k.Network,
+		Depth:      k.Depth + 1,
+		ParentFP:   parentFP,
+		ChildIndex: index,
+		ChainCode:  childChainCode,
+		PublicKey:  childPub,
+	}, true, nil
+}
+
+// publicKeyFromCoords rebuilds a btcec.PublicKey from the affine
+// coordinates produced by the curve arithmetic in deriveOnce's
+// public-key derivation path.
+func publicKeyFromCoords(x, y *big.Int) (*btcec.PublicKey, error) {
+	var fx, fy btcec.FieldVal
+	if overflow := fx.SetByteSlice(x.Bytes()); overflow {
+		return nil, fmt.Errorf("hd: derived public key x-coordinate overflows the field")
+	}
+	if overflow := fy.SetByteSlice(y.Bytes()); overflow {
+		return nil, fmt.Errorf("hd: derived public key y-coordinate overflows the field")
+	}
+	return btcec.NewPublicKey(&fx, &fy), nil
+}
+
+// validPrivateScalar reports whether b parses as a nonzero scalar less
+// than the secp256k1 curve order, the validity condition BIP-32
+// imposes on a derivation's IL output.
+func validPrivateScalar(b []byte) bool {
+	n := new(big.Int).SetBytes(b)
+	return n.Sign() != 0 && n.Cmp(btcec.S256().N) < 0
+}
+
+// fingerprint is the first 4 bytes of HASH160(serP(pub)), used as a
+// child's ParentFP per BIP-32.
+func fingerprint(pub *btcec.PublicKey) [4]byte {
+	h := btcutil.Hash160(pub.SerializeCompressed())
+	var fp [4]byte
+	copy(fp[:], h[:4])
+	return fp
+}
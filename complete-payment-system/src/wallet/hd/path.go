@@ -0,0 +1,42 @@
+package hd
+
+import "fmt"
+
+// purposeBIP44 is the hardened "purpose" level BIP-44 fixes at 44'.
+const purposeBIP44 = 44 + hardenedOffset
+
+// DeriveAccount derives the BIP-44 account-level key m/44'/coin'/account'
+// from master, the common ancestor CreateWallet and later
+// GeneratePaymentAddress calls both derive from so they agree on the
+// same chain/index space for a given wallet.
+func DeriveAccount(master *ExtendedKey, coin Coin, account uint32) (*ExtendedKey, error) {
+	purpose, err := master.Derive(purposeBIP44)
+	if err != nil {
+		return nil, fmt.Errorf("hd: deriving purpose level: %w", err)
+	}
+	coinKey, err := purpose.Derive(coin.Type + hardenedOffset)
+	if err != nil {
+		return nil, fmt.Errorf("hd: deriving coin level for %s: %w", coin.Name, err)
+	}
+	accountKey, err := coinKey.Derive(account + hardenedOffset)
+	if err != nil {
+		return nil, fmt.Errorf("hd: deriving account %d: %w", account, err)
+	}
+	return accountKey, nil
+}
+
+// DeriveAddress derives m/44'/coin'/account'/chain/index from an
+// account-level key produced by DeriveAccount. chain is conventionally
+// 0 for external (receive) addresses and 1 for internal (change)
+// addresses.
+func DeriveAddress(accountKey *ExtendedKey, chain, index uint32) (*ExtendedKey, error) {
+	chainKey, err := accountKey.Derive(chain)
+	if err != nil {
+		return nil, fmt.Errorf("hd: deriving chain %d: %w", chain, err)
+	}
+	addressKey, err := chainKey.Derive(index)
+	if err != nil {
+		return nil, fmt.Errorf("hd: deriving address index %d: %w", index, err)
+	}
+	return addressKey, nil
+}
@@ -0,0 +1,47 @@
+// Package hd implements BIP-32/BIP-39/BIP-44 hierarchical-deterministic
+// key derivation, so a single Seed can back every Wallet a merchant
+// holds across currencies, mirroring the address-manager approach used
+// by btcwallet/coreth keystores rather than generating and storing one
+// independent key per address.
+package hd
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// Seed is 256 bits of entropy a MasterKey is derived from. It is never
+// itself a private key — NewMasterKey runs it through HMAC-SHA512 first.
+type Seed [32]byte
+
+// NewSeed generates a new random Seed.
+func NewSeed() (Seed, error) {
+	var s Seed
+	if _, err := rand.Read(s[:]); err != nil {
+		return Seed{}, fmt.Errorf("hd: generating seed: %w", err)
+	}
+	return s, nil
+}
+
+// NewMnemonic encodes s as a BIP-39 mnemonic phrase, so it can be
+// written down and later recovered with SeedFromMnemonic.
+func NewMnemonic(s Seed) (string, error) {
+	mnemonic, err := bip39.NewMnemonic(s[:])
+	if err != nil {
+		return "", fmt.Errorf("hd: encoding mnemonic: %w", err)
+	}
+	return mnemonic, nil
+}
+
+// SeedFromMnemonic validates mnemonic and stretches it (with passphrase,
+// which may be empty) into the 64-byte BIP-39 seed used directly by
+// NewMasterKey. Unlike Seed, this returned slice is not limited to 256
+// bits of entropy — it is the PBKDF2-stretched output BIP-32 expects.
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("hd: invalid mnemonic")
+	}
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
@@ -0,0 +1,132 @@
+package hd
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// serializedKeyLen is the fixed length of a BIP-32 extended key payload
+// before the base58check checksum: version(4) + depth(1) + parentFP(4)
+// + childIndex(4) + chaincode(32) + keydata(33).
+const serializedKeyLen = 4 + 1 + 4 + 4 + 32 + 33
+
+// String serializes k to its base58check xprv (or xpub, if k is
+// public-only) form, following BIP-32 exactly. A WalletTypeCold wallet
+// should call Neuter().String() so only the xpub ever leaves the
+// signing device.
+func (k *ExtendedKey) String() string {
+	payload := make([]byte, 0, serializedKeyLen)
+
+	var version uint32
+	if k.IsPrivate() {
+		version = k.Network.PrivateVersion
+	} else {
+		version = k.Network.PublicVersion
+	}
+	var versionBytes [4]byte
+	binary.BigEndian.PutUint32(versionBytes[:], version)
+	payload = append(payload, versionBytes[:]...)
+
+	payload = append(payload, k.Depth)
+	payload = append(payload, k.ParentFP[:]...)
+
+	var idxBytes [4]byte
+	binary.BigEndian.PutUint32(idxBytes[:], k.ChildIndex)
+	payload = append(payload, idxBytes[:]...)
+
+	payload = append(payload, k.ChainCode[:]...)
+
+	if k.IsPrivate() {
+		payload = append(payload, 0x00)
+		payload = append(payload, k.PrivateKey.Serialize()...)
+	} else {
+		payload = append(payload, k.PublicKey.SerializeCompressed()...)
+	}
+
+	return base58.Encode(append(payload, checksum(payload)...))
+}
+
+// ParseExtendedKey decodes an xprv/xpub base58check string produced by
+// String back into an ExtendedKey.
+func ParseExtendedKey(s string) (*ExtendedKey, error) {
+	decoded := base58.Decode(s)
+	if len(decoded) != serializedKeyLen+4 {
+		return nil, fmt.Errorf("hd: malformed extended key: wrong length")
+	}
+
+	payload, sum := decoded[:serializedKeyLen], decoded[serializedKeyLen:]
+	want := checksum(payload)
+	for i := range want {
+		if want[i] != sum[i] {
+			return nil, fmt.Errorf("hd: malformed extended key: checksum mismatch")
+		}
+	}
+
+	version := binary.BigEndian.Uint32(payload[0:4])
+	depth := payload[4]
+	var parentFP [4]byte
+	copy(parentFP[:], payload[5:9])
+	childIndex := binary.BigEndian.Uint32(payload[9:13])
+	var chainCode [32]byte
+	copy(chainCode[:], payload[13:45])
+	keyData := payload[45:78]
+
+	network, private, err := networkFor(version)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &ExtendedKey{
+		Network:    network,
+		Depth:      depth,
+		ParentFP:   parentFP,
+		ChildIndex: childIndex,
+		ChainCode:  chainCode,
+	}
+
+	if private {
+		if keyData[0] != 0x00 {
+			return nil, fmt.Errorf("hd: malformed extended private key padding byte")
+		}
+		priv, pub := btcec.PrivKeyFromBytes(keyData[1:])
+		k.PrivateKey = priv
+		k.PublicKey = pub
+	} else {
+		pub, err := btcec.ParsePubKey(keyData)
+		if err != nil {
+			return nil, fmt.Errorf("hd: malformed extended public key: %w", err)
+		}
+		k.PublicKey = pub
+	}
+
+	return k, nil
+}
+
+// networkFor maps a serialized version prefix back to the Network (and
+// private/public-ness) String used to produce it.
+func networkFor(version uint32) (network Network, private bool, err error) {
+	switch version {
+	case MainNet.PrivateVersion:
+		return MainNet, true, nil
+	case MainNet.PublicVersion:
+		return MainNet, false, nil
+	case TestNet.PrivateVersion:
+		return TestNet, true, nil
+	case TestNet.PublicVersion:
+		return TestNet, false, nil
+	default:
+		return Network{}, false, fmt.Errorf("hd: unrecognized extended key version 0x%08x", version)
+	}
+}
+
+// checksum is the first 4 bytes of a double-SHA256 of payload, per
+// base58check.
+func checksum(payload []byte) []byte {
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
@@ -0,0 +1,173 @@
+package keystore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// unlockedEntry is one wallet's cached plaintext private key plus the
+// timer that will wipe it.
+type unlockedEntry struct {
+	key   []byte
+	timer *time.Timer
+}
+
+// FileKeyStore is a KeyStore that writes one Web3 Secret Storage v3
+// JSON file per wallet under Dir, named "<walletID>.json", mirroring
+// go-ethereum's keystore directory layout.
+type FileKeyStore struct {
+	// Dir is the directory key files are read from and written to. It
+	// is created (0700) on first Store if it doesn't already exist.
+	Dir string
+
+	mu       sync.Mutex
+	unlocked map[string]*unlockedEntry
+}
+
+// NewFileKeyStore creates a FileKeyStore rooted at dir.
+func NewFileKeyStore(dir string) *FileKeyStore {
+	return &FileKeyStore{Dir: dir, unlocked: make(map[string]*unlockedEntry)}
+}
+
+func (s *FileKeyStore) path(walletID string) string {
+	return filepath.Join(s.Dir, walletID+".json")
+}
+
+// Store encrypts privKey under passphrase and writes walletID's key
+// file, overwriting any existing one.
+func (s *FileKeyStore) Store(walletID string, privKey []byte, passphrase string) error {
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return fmt.Errorf("keystore: creating %s: %w", s.Dir, err)
+	}
+
+	id, err := newUUID()
+	if err != nil {
+		return err
+	}
+	kf, err := encryptKeyFile(id, walletID, privKey, passphrase)
+	if err != nil {
+		return err
+	}
+	data, err := marshalKeyFile(kf)
+	if err != nil {
+		return fmt.Errorf("keystore: encoding key file: %w", err)
+	}
+
+	if err := os.WriteFile(s.path(walletID), data, 0o600); err != nil {
+		return fmt.Errorf("keystore: writing key file for %s: %w", walletID, err)
+	}
+	return nil
+}
+
+// Load decrypts and returns walletID's private key.
+func (s *FileKeyStore) Load(walletID, passphrase string) ([]byte, error) {
+	kf, err := s.readKeyFile(walletID)
+	if err != nil {
+		return nil, err
+	}
+	return decryptKeyFile(kf, passphrase)
+}
+
+func (s *FileKeyStore) readKeyFile(walletID string) (*keyFile, error) {
+	data, err := os.ReadFile(s.path(walletID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("keystore: no key file stored for wallet %s", walletID)
+		}
+		return nil, fmt.Errorf("keystore: reading key file for %s: %w", walletID, err)
+	}
+	return unmarshalKeyFile(data)
+}
+
+// Unlock decrypts walletID's private key and caches it for timeout,
+// after which it is wiped automatically. Calling Unlock again before
+// timeout elapses replaces the cached key and resets the timer.
+func (s *FileKeyStore) Unlock(walletID, passphrase string, timeout time.Duration) error {
+	key, err := s.Load(walletID, passphrase)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.unlocked[walletID]; ok {
+		existing.timer.Stop()
+	}
+	entry := &unlockedEntry{key: key}
+	// entry is captured by value (as a pointer) so this timer only ever
+	// wipes the entry it was scheduled for — if Unlock replaces
+	// s.unlocked[walletID] with a newer entry before this fires, a Stop
+	// that loses the race (because the timer already fired) must not
+	// let this stale callback delete the newer entry.
+	entry.timer = time.AfterFunc(timeout, func() { s.expire(walletID, entry) })
+	s.unlocked[walletID] = entry
+	return nil
+}
+
+// expire wipes walletID's cached key only if the currently-stored
+// entry is still the one this callback was scheduled for, so a
+// just-replaced (re-Unlock'd) entry is never torn down by its
+// predecessor's expiring timer.
+func (s *FileKeyStore) expire(walletID string, entry *unlockedEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.unlocked[walletID] != entry {
+		return
+	}
+	wipe(entry.key)
+	delete(s.unlocked, walletID)
+}
+
+// Lock wipes walletID's cached private key, if any.
+func (s *FileKeyStore) Lock(walletID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.unlocked[walletID]
+	if !ok {
+		return nil
+	}
+	entry.timer.Stop()
+	wipe(entry.key)
+	delete(s.unlocked, walletID)
+	return nil
+}
+
+// wipe zeroes key in place.
+func wipe(key []byte) {
+	for i := range key {
+		key[i] = 0
+	}
+}
+
+// UnlockedKey returns a copy of walletID's cached private key, if
+// Unlock'd and not yet locked/expired. A copy is returned (rather than
+// the live cached slice) so a concurrent Lock/expire can't zero the
+// bytes out from under a caller that's mid-signature.
+func (s *FileKeyStore) UnlockedKey(walletID string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.unlocked[walletID]
+	if !ok {
+		return nil, false
+	}
+	key := make([]byte, len(entry.key))
+	copy(key, entry.key)
+	return key, true
+}
+
+// ChangePassphrase decrypts walletID's key file with old and
+// re-encrypts it under new, replacing the stored file. Any cached
+// unlocked key for walletID is left untouched — the caller must Unlock
+// again with the new passphrase the next time it's needed after a
+// restart, but a still-unlocked in-memory key remains valid until its
+// own timeout.
+func (s *FileKeyStore) ChangePassphrase(walletID, old, new string) error {
+	key, err := s.Load(walletID, old)
+	if err != nil {
+		return err
+	}
+	return s.Store(walletID, key, new)
+}
@@ -0,0 +1,58 @@
+// Package keystore encrypts wallet private keys at rest using the
+// Web3 Secret Storage v3 JSON format (the same schema go-ethereum's
+// keystore writes), so WalletService never holds a private key in
+// plaintext outside the short window a caller has explicitly unlocked
+// it for.
+package keystore
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrLocked is returned by an operation (e.g. TransferFunds, through
+// WalletService) that needs a wallet's private key while it is locked.
+var ErrLocked = errors.New("keystore: wallet is locked")
+
+// ErrWrongPassphrase is returned by Load/Unlock/ChangePassphrase when
+// the MAC check fails, meaning the supplied passphrase didn't derive
+// the key this wallet's file was encrypted with.
+var ErrWrongPassphrase = errors.New("keystore: wrong passphrase")
+
+// KeyStore stores and retrieves wallet private keys, encrypted at
+// rest under a passphrase the caller supplies for every operation that
+// needs one. Unlock/Lock let WalletService cache a decrypted key in
+// memory for a bounded window instead of asking for the passphrase on
+// every signing operation, the same tradeoff geth's
+// personal_unlockAccount / walletpassphrase RPCs make.
+type KeyStore interface {
+	// Store encrypts privKey under passphrase and persists it for
+	// walletID, following the Web3 Secret Storage v3 schema.
+	Store(walletID string, privKey []byte, passphrase string) error
+
+	// Load decrypts and returns walletID's private key. It does not
+	// consult or affect Unlock's cache — TransferFunds-style callers
+	// should prefer Unlock once, then read through the unlocked cache
+	// so a passphrase isn't needed for every signature.
+	Load(walletID, passphrase string) ([]byte, error)
+
+	// Unlock decrypts walletID's private key and caches it in memory
+	// for timeout, after which it is automatically wiped (Lock need
+	// not be called explicitly, though it may be, to relock early).
+	Unlock(walletID, passphrase string, timeout time.Duration) error
+
+	// Lock immediately wipes walletID's cached private key, if
+	// Unlock'd. Locking an already-locked (or never-unlocked) wallet
+	// is not an error.
+	Lock(walletID string) error
+
+	// ChangePassphrase re-encrypts walletID's stored key under new,
+	// after verifying old against the existing file.
+	ChangePassphrase(walletID, old, new string) error
+
+	// UnlockedKey returns walletID's cached private key if Unlock'd
+	// and not yet expired/locked, for WalletService to sign with
+	// without re-prompting for a passphrase. ok is false (not an
+	// error) when the wallet is locked.
+	UnlockedKey(walletID string) (key []byte, ok bool)
+}
@@ -0,0 +1,305 @@
+package keystore
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestMain lowers the scrypt cost parameters for the duration of this
+// package's tests, so exercising encrypt/decrypt dozens of times stays
+// fast; production code always uses the real, expensive defaults.
+func TestMain(m *testing.M) {
+	scryptN, scryptR, scryptP = 1024, 1, 1
+	os.Exit(m.Run())
+}
+
+func testPrivKey() []byte {
+	return bytes.Repeat([]byte{0x42}, 32)
+}
+
+func TestFileKeyStore_StoreAndLoadRoundTrip(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	privKey := testPrivKey()
+
+	if err := ks.Store("wallet1", privKey, "correct horse"); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+
+	got, err := ks.Load("wallet1", "correct horse")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !bytes.Equal(got, privKey) {
+		t.Fatalf("Load returned %x, want %x", got, privKey)
+	}
+}
+
+func TestFileKeyStore_LoadWrongPassphrase(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	if err := ks.Store("wallet1", testPrivKey(), "correct horse"); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+
+	if _, err := ks.Load("wallet1", "wrong passphrase"); err != ErrWrongPassphrase {
+		t.Fatalf("Load with wrong passphrase: got %v, want ErrWrongPassphrase", err)
+	}
+}
+
+func TestFileKeyStore_LoadUnknownWallet(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	if _, err := ks.Load("does-not-exist", "whatever"); err == nil {
+		t.Fatal("expected an error loading a wallet that was never stored")
+	}
+}
+
+func TestFileKeyStore_UnlockCachesAndLockWipes(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	privKey := testPrivKey()
+	if err := ks.Store("wallet1", privKey, "pass"); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+
+	if _, ok := ks.UnlockedKey("wallet1"); ok {
+		t.Fatal("wallet should not be unlocked before Unlock is called")
+	}
+
+	if err := ks.Unlock("wallet1", "pass", time.Minute); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+
+	key, ok := ks.UnlockedKey("wallet1")
+	if !ok {
+		t.Fatal("expected wallet1 to be unlocked")
+	}
+	if !bytes.Equal(key, privKey) {
+		t.Fatalf("UnlockedKey returned %x, want %x", key, privKey)
+	}
+
+	if err := ks.Lock("wallet1"); err != nil {
+		t.Fatalf("Lock: %v", err)
+	}
+	if _, ok := ks.UnlockedKey("wallet1"); ok {
+		t.Fatal("wallet should not be unlocked after Lock")
+	}
+}
+
+func TestFileKeyStore_UnlockWrongPassphraseDoesNotCache(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	if err := ks.Store("wallet1", testPrivKey(), "pass"); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+	if err := ks.Unlock("wallet1", "wrong", time.Minute); err != ErrWrongPassphrase {
+		t.Fatalf("Unlock with wrong passphrase: got %v, want ErrWrongPassphrase", err)
+	}
+	if _, ok := ks.UnlockedKey("wallet1"); ok {
+		t.Fatal("wallet should not be unlocked after a failed Unlock")
+	}
+}
+
+func TestFileKeyStore_UnlockExpires(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	if err := ks.Store("wallet1", testPrivKey(), "pass"); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+	if err := ks.Unlock("wallet1", "pass", 10*time.Millisecond); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if _, ok := ks.UnlockedKey("wallet1"); !ok {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("expected the unlocked key to expire within the timeout")
+}
+
+func TestFileKeyStore_ReUnlockReplacesCachedKey(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	if err := ks.Store("wallet1", testPrivKey(), "pass"); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+
+	if err := ks.Unlock("wallet1", "pass", 20*time.Millisecond); err != nil {
+		t.Fatalf("first Unlock: %v", err)
+	}
+	// Replace the entry with a longer timeout before the first one fires.
+	if err := ks.Unlock("wallet1", "pass", time.Minute); err != nil {
+		t.Fatalf("second Unlock: %v", err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if _, ok := ks.UnlockedKey("wallet1"); !ok {
+		t.Fatal("re-Unlock with a longer timeout should not have been wiped by the earlier timer")
+	}
+}
+
+func TestFileKeyStore_LockUnknownWalletIsNotAnError(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	if err := ks.Lock("never-unlocked"); err != nil {
+		t.Fatalf("Lock on a wallet that was never unlocked: %v", err)
+	}
+}
+
+func TestFileKeyStore_ChangePassphrase(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	privKey := testPrivKey()
+	if err := ks.Store("wallet1", privKey, "old"); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+
+	if err := ks.ChangePassphrase("wallet1", "old", "new"); err != nil {
+		t.Fatalf("ChangePassphrase: %v", err)
+	}
+
+	if _, err := ks.Load("wallet1", "old"); err != ErrWrongPassphrase {
+		t.Fatalf("Load with the old passphrase after rotation: got %v, want ErrWrongPassphrase", err)
+	}
+
+	got, err := ks.Load("wallet1", "new")
+	if err != nil {
+		t.Fatalf("Load with the new passphrase: %v", err)
+	}
+	if !bytes.Equal(got, privKey) {
+		t.Fatalf("Load after ChangePassphrase returned %x, want %x", got, privKey)
+	}
+}
+
+func TestFileKeyStore_ChangePassphraseWrongOld(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	if err := ks.Store("wallet1", testPrivKey(), "old"); err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+	if err := ks.ChangePassphrase("wallet1", "wrong", "new"); err != ErrWrongPassphrase {
+		t.Fatalf("ChangePassphrase with wrong old passphrase: got %v, want ErrWrongPassphrase", err)
+	}
+}
+
+func TestStoreOverwritesExistingKeyFile(t *testing.T) {
+	ks := NewFileKeyStore(t.TempDir())
+	key1 := testPrivKey()
+	key2 := bytes.Repeat([]byte{0x99}, 32)
+
+	if err := ks.Store("wallet1", key1, "pass"); err != nil {
+		t.Fatalf("first Store: %v", err)
+	}
+	if err := ks.Store("wallet1", key2, "pass"); err != nil {
+		t.Fatalf("second Store: %v", err)
+	}
+
+	got, err := ks.Load("wallet1", "pass")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !bytes.Equal(got, key2) {
+		t.Fatalf("Load after overwrite returned %x, want %x", got, key2)
+	}
+}
+
+func TestEncryptKeyFileUsesRandomSalt(t *testing.T) {
+	privKey := testPrivKey()
+	kf1, err := encryptKeyFile("id1", "wallet1", privKey, "pass")
+	if err != nil {
+		t.Fatalf("encryptKeyFile: %v", err)
+	}
+	kf2, err := encryptKeyFile("id2", "wallet1", privKey, "pass")
+	if err != nil {
+		t.Fatalf("encryptKeyFile: %v", err)
+	}
+
+	if kf1.Crypto.KDFParams.Salt == kf2.Crypto.KDFParams.Salt {
+		t.Fatal("two calls to encryptKeyFile produced the same salt")
+	}
+	if kf1.Crypto.CipherText == kf2.Crypto.CipherText {
+		t.Fatal("two calls to encryptKeyFile produced the same ciphertext")
+	}
+
+	decrypted, err := decryptKeyFile(kf1, "pass")
+	if err != nil {
+		t.Fatalf("decryptKeyFile: %v", err)
+	}
+	if !bytes.Equal(decrypted, privKey) {
+		t.Fatalf("decryptKeyFile returned %x, want %x", decrypted, privKey)
+	}
+}
+
+func TestDecryptKeyFileRejectsTamperedCiphertext(t *testing.T) {
+	kf, err := encryptKeyFile("id1", "wallet1", testPrivKey(), "pass")
+	if err != nil {
+		t.Fatalf("encryptKeyFile: %v", err)
+	}
+
+	// Flip a character in the stored ciphertext so the MAC no longer matches.
+	tampered := []byte(kf.Crypto.CipherText)
+	if tampered[0] == '0' {
+		tampered[0] = '1'
+	} else {
+		tampered[0] = '0'
+	}
+	kf.Crypto.CipherText = string(tampered)
+
+	if _, err := decryptKeyFile(kf, "pass"); err != ErrWrongPassphrase {
+		t.Fatalf("decryptKeyFile with tampered ciphertext: got %v, want ErrWrongPassphrase", err)
+	}
+}
+
+func TestDecryptKeyFileRejectsUnsupportedVersion(t *testing.T) {
+	kf, err := encryptKeyFile("id1", "wallet1", testPrivKey(), "pass")
+	if err != nil {
+		t.Fatalf("encryptKeyFile: %v", err)
+	}
+	kf.Version = 2
+	if _, err := decryptKeyFile(kf, "pass"); err == nil {
+		t.Fatal("expected an error decrypting an unsupported key file version")
+	}
+}
+
+func TestMarshalUnmarshalKeyFileRoundTrip(t *testing.T) {
+	kf, err := encryptKeyFile("id1", "0xabc", testPrivKey(), "pass")
+	if err != nil {
+		t.Fatalf("encryptKeyFile: %v", err)
+	}
+
+	data, err := marshalKeyFile(kf)
+	if err != nil {
+		t.Fatalf("marshalKeyFile: %v", err)
+	}
+	decoded, err := unmarshalKeyFile(data)
+	if err != nil {
+		t.Fatalf("unmarshalKeyFile: %v", err)
+	}
+
+	if decoded.Version != kf.Version || decoded.ID != kf.ID || decoded.Address != kf.Address {
+		t.Fatalf("round trip changed top-level fields: got %+v, want %+v", decoded, kf)
+	}
+	if decoded.Crypto != kf.Crypto {
+		t.Fatalf("round trip changed crypto params: got %+v, want %+v", decoded.Crypto, kf.Crypto)
+	}
+}
+
+func TestNewUUIDIsVersion4Variant10(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 16; i++ {
+		id, err := newUUID()
+		if err != nil {
+			t.Fatalf("newUUID: %v", err)
+		}
+		if seen[id] {
+			t.Fatalf("newUUID produced a duplicate: %s", id)
+		}
+		seen[id] = true
+
+		// xxxxxxxx-xxxx-4xxx-{8,9,a,b}xxx-xxxxxxxxxxxx
+		if id[14] != '4' {
+			t.Fatalf("newUUID() = %s, want version nibble '4' at index 14", id)
+		}
+		switch id[19] {
+		case '8', '9', 'a', 'b':
+		default:
+			t.Fatalf("newUUID() = %s, want variant nibble in {8,9,a,b} at index 19", id)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package keystore
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newUUID generates a random (v4) UUID for a key file's "id" field, the
+// same role go-ethereum's keystore uses google/uuid for. This package
+// avoids the extra dependency since crypto/rand plus RFC 4122's two
+// fixed bits are all a v4 UUID needs.
+func newUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("keystore: generating uuid: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
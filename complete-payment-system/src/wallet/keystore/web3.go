@@ -0,0 +1,187 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+)
+
+// Web3 Secret Storage v3 scrypt parameters. These match geth's default
+// "light" KDF cost — expensive enough to meaningfully slow an offline
+// brute force, cheap enough to unlock a wallet in well under a second.
+//
+// scryptN, scryptR and scryptP are vars rather than consts solely so
+// the package's own tests can lower the cost and run quickly; no
+// production code path ever changes them.
+var (
+	scryptN = 262144
+	scryptR = 8
+	scryptP = 1
+)
+
+const scryptDKLen = 32
+
+// keyFileVersion is the only Web3 Secret Storage version this package
+// writes or accepts.
+const keyFileVersion = 3
+
+// keyFile is the Web3 Secret Storage v3 JSON document, serialized
+// byte-for-byte compatible with go-ethereum's keystore so the same
+// file could, in principle, be opened by either.
+type keyFile struct {
+	Version int          `json:"version"`
+	ID      string       `json:"id"`
+	Address string       `json:"address"`
+	Crypto  cryptoParams `json:"crypto"`
+}
+
+type cryptoParams struct {
+	Cipher       string       `json:"cipher"`
+	CipherText   string       `json:"ciphertext"`
+	CipherParams cipherParams `json:"cipherparams"`
+	KDF          string       `json:"kdf"`
+	KDFParams    kdfParams    `json:"kdfparams"`
+	MAC          string       `json:"mac"`
+}
+
+type cipherParams struct {
+	IV string `json:"iv"`
+}
+
+type kdfParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Salt  string `json:"salt"`
+}
+
+// encryptKeyFile encrypts privKey under passphrase into a Web3 Secret
+// Storage v3 document for address, identified by id (a UUID).
+func encryptKeyFile(id, address string, privKey []byte, passphrase string) (*keyFile, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("keystore: generating salt: %w", err)
+	}
+
+	dk, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: deriving key: %w", err)
+	}
+	encryptionKey, macKey := dk[:16], dk[16:32]
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, fmt.Errorf("keystore: generating iv: %w", err)
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: constructing cipher: %w", err)
+	}
+	ciphertext := make([]byte, len(privKey))
+	cipher.NewCTR(block, iv).XORKeyStream(ciphertext, privKey)
+
+	mac := keccak256Mac(macKey, ciphertext)
+
+	return &keyFile{
+		Version: keyFileVersion,
+		ID:      id,
+		Address: address,
+		Crypto: cryptoParams{
+			Cipher:       "aes-128-ctr",
+			CipherText:   hex.EncodeToString(ciphertext),
+			CipherParams: cipherParams{IV: hex.EncodeToString(iv)},
+			KDF:          "scrypt",
+			KDFParams: kdfParams{
+				DKLen: scryptDKLen,
+				N:     scryptN,
+				R:     scryptR,
+				P:     scryptP,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+	}, nil
+}
+
+// decryptKeyFile recovers the private key kf.Crypto.CipherText
+// encrypts, after verifying passphrase derives the recorded MAC.
+func decryptKeyFile(kf *keyFile, passphrase string) ([]byte, error) {
+	if kf.Version != keyFileVersion {
+		return nil, fmt.Errorf("keystore: unsupported key file version %d", kf.Version)
+	}
+	if kf.Crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("keystore: unsupported cipher %q", kf.Crypto.Cipher)
+	}
+	if kf.Crypto.KDF != "scrypt" {
+		return nil, fmt.Errorf("keystore: unsupported kdf %q", kf.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(kf.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: malformed salt: %w", err)
+	}
+	iv, err := hex.DecodeString(kf.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: malformed iv: %w", err)
+	}
+	ciphertext, err := hex.DecodeString(kf.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: malformed ciphertext: %w", err)
+	}
+	wantMAC, err := hex.DecodeString(kf.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: malformed mac: %w", err)
+	}
+
+	p := kf.Crypto.KDFParams
+	dk, err := scrypt.Key([]byte(passphrase), salt, p.N, p.R, p.P, p.DKLen)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: deriving key: %w", err)
+	}
+	encryptionKey, macKey := dk[:16], dk[16:32]
+
+	gotMAC := keccak256Mac(macKey, ciphertext)
+	if subtle.ConstantTimeCompare(gotMAC, wantMAC) != 1 {
+		return nil, ErrWrongPassphrase
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: constructing cipher: %w", err)
+	}
+	privKey := make([]byte, len(ciphertext))
+	cipher.NewCTR(block, iv).XORKeyStream(privKey, ciphertext)
+
+	return privKey, nil
+}
+
+// keccak256Mac computes the Web3 Secret Storage MAC:
+// Keccak-256(macKey || ciphertext).
+func keccak256Mac(macKey, ciphertext []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(macKey)
+	h.Write(ciphertext)
+	return h.Sum(nil)
+}
+
+func marshalKeyFile(kf *keyFile) ([]byte, error) {
+	return json.MarshalIndent(kf, "", "  ")
+}
+
+func unmarshalKeyFile(data []byte) (*keyFile, error) {
+	var kf keyFile
+	if err := json.Unmarshal(data, &kf); err != nil {
+		return nil, fmt.Errorf("keystore: parsing key file: %w", err)
+	}
+	return &kf, nil
+}
@@ -0,0 +1,22 @@
+package psbt
+
+// Key types. Values follow BIP-174's assignments where this format
+// carries a direct equivalent; KeyTypeGlobalUnsignedTx's value is this
+// system's JSON-encoded UnsignedTx rather than a Bitcoin wire
+// transaction (see the package doc comment).
+var (
+	// Global map.
+	KeyTypeGlobalUnsignedTx = []byte{0x00}
+
+	// Input map.
+	KeyTypeInputWitnessUTXO    = []byte{0x01}
+	KeyTypeInputNonWitnessUTXO = []byte{0x02}
+	KeyTypeInputDerivationPath = []byte{0x03}
+	KeyTypeInputPartialSig     = []byte{0x04}
+	KeyTypeInputFinalized      = []byte{0x05}
+	KeyTypeInputPubKey         = []byte{0x06}
+
+	// Output map.
+	KeyTypeOutputAddress = []byte{0x01}
+	KeyTypeOutputAmount  = []byte{0x02}
+)
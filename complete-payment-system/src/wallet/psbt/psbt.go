@@ -0,0 +1,169 @@
+// Package psbt implements a BIP-174-inspired partially-signed
+// transaction format: a hot node builds a PSBT (inputs, outputs, no
+// signatures), a cold node signs it offline, and the hot node
+// finalizes and broadcasts it — so a WalletTypeCold wallet's key never
+// has to touch a network-connected machine.
+//
+// This is "PSBT-style" rather than a byte-for-byte BIP-174
+// implementation: the global unsigned transaction is this system's own
+// chain-agnostic UnsignedTx (JSON-encoded), not a Bitcoin wire
+// transaction, matching how chain.Broadcaster already treats a signed
+// transaction as an opaque []byte regardless of which chain it's for.
+// The binary envelope (magic, key-value maps, varint-prefixed
+// key/value lengths, 0x00 map terminator) follows BIP-174 exactly, so
+// the same offline-transport tooling (QR, USB, air-gapped file copy)
+// works unmodified.
+package psbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+)
+
+// Magic is the fixed 5-byte prefix of every serialized PSBT, per
+// BIP-174.
+var Magic = [5]byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+// KeyValue is one entry in a Map: Key's first byte is the key type,
+// the rest (if any) is key data (e.g. a derivation fingerprint); Value
+// is the type-specific payload.
+type KeyValue struct {
+	Key   []byte
+	Value []byte
+}
+
+// Map is an ordered list of key-value pairs for one PSBT section (the
+// global map, or one input's or output's map), serialized terminated
+// by a single zero-length key.
+type Map []KeyValue
+
+// Get returns the value for the first entry whose Key matches key.
+func (m Map) Get(key []byte) ([]byte, bool) {
+	for _, kv := range m {
+		if bytes.Equal(kv.Key, key) {
+			return kv.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Set replaces the value for key if already present, or appends a new
+// entry.
+func (m *Map) Set(key, value []byte) {
+	for i, kv := range *m {
+		if bytes.Equal(kv.Key, key) {
+			(*m)[i].Value = value
+			return
+		}
+	}
+	*m = append(*m, KeyValue{Key: key, Value: value})
+}
+
+// PSBT is a partially-signed transaction: one global map plus one map
+// per input and per output, mirroring BIP-174's section layout.
+type PSBT struct {
+	Global  Map
+	Inputs  []Map
+	Outputs []Map
+}
+
+// Serialize encodes p into its canonical binary form: Magic, then the
+// global map, then each input's map, then each output's map, each map
+// terminated by a single 0x00 byte.
+func (p *PSBT) Serialize() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.Write(Magic[:])
+	writeMap(buf, p.Global)
+	for _, in := range p.Inputs {
+		writeMap(buf, in)
+	}
+	for _, out := range p.Outputs {
+		writeMap(buf, out)
+	}
+	return buf.Bytes(), nil
+}
+
+// Deserialize parses data (as produced by Serialize) back into a PSBT.
+// inputCount and outputCount must be known ahead of time (read from
+// the global map's PSBT_GLOBAL_INPUT_COUNT/OUTPUT_COUNT keys by the
+// caller) since, unlike BIP-174's real unsigned transaction, this
+// system's JSON UnsignedTx isn't walked to recover them.
+func Deserialize(data []byte, inputCount, outputCount int) (*PSBT, error) {
+	if len(data) < len(Magic) || !bytes.Equal(data[:len(Magic)], Magic[:]) {
+		return nil, fmt.Errorf("psbt: missing or invalid magic bytes")
+	}
+	r := bytes.NewReader(data[len(Magic):])
+
+	global, err := readMap(r)
+	if err != nil {
+		return nil, fmt.Errorf("psbt: reading global map: %w", err)
+	}
+
+	inputs := make([]Map, inputCount)
+	for i := range inputs {
+		inputs[i], err = readMap(r)
+		if err != nil {
+			return nil, fmt.Errorf("psbt: reading input %d map: %w", i, err)
+		}
+	}
+
+	outputs := make([]Map, outputCount)
+	for i := range outputs {
+		outputs[i], err = readMap(r)
+		if err != nil {
+			return nil, fmt.Errorf("psbt: reading output %d map: %w", i, err)
+		}
+	}
+
+	return &PSBT{Global: global, Inputs: inputs, Outputs: outputs}, nil
+}
+
+// Base64 encodes p's Serialize output as base64, the form BIP-174
+// recommends for copy/paste or QR-code transport between an online and
+// an offline (cold) machine.
+func (p *PSBT) Base64() (string, error) {
+	data, err := p.Serialize()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// FromBase64 decodes a PSBT previously encoded with Base64.
+func FromBase64(s string, inputCount, outputCount int) (*PSBT, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("psbt: invalid base64: %w", err)
+	}
+	return Deserialize(data, inputCount, outputCount)
+}
+
+// writeMap serializes m as a sequence of varint-length-prefixed
+// key/value pairs, terminated by a single 0x00 (zero-length key).
+func writeMap(buf *bytes.Buffer, m Map) {
+	for _, kv := range m {
+		writeVarBytes(buf, kv.Key)
+		writeVarBytes(buf, kv.Value)
+	}
+	buf.WriteByte(0x00)
+}
+
+// readMap parses a map serialized by writeMap.
+func readMap(r *bytes.Reader) (Map, error) {
+	var m Map
+	for {
+		key, err := readVarBytes(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) == 0 {
+			return m, nil
+		}
+		value, err := readVarBytes(r)
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, KeyValue{Key: key, Value: value})
+	}
+}
@@ -0,0 +1,213 @@
+package psbt
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMapGetSet(t *testing.T) {
+	var m Map
+	if _, ok := m.Get(KeyTypeInputPubKey); ok {
+		t.Fatal("Get on an empty map should report not found")
+	}
+
+	m.Set(KeyTypeInputPubKey, []byte("first"))
+	if got, ok := m.Get(KeyTypeInputPubKey); !ok || string(got) != "first" {
+		t.Fatalf("Get after Set = (%q, %v), want (\"first\", true)", got, ok)
+	}
+
+	// Set on an existing key replaces the value rather than appending.
+	m.Set(KeyTypeInputPubKey, []byte("second"))
+	if len(m) != 1 {
+		t.Fatalf("Set on an existing key changed the map length to %d, want 1", len(m))
+	}
+	if got, _ := m.Get(KeyTypeInputPubKey); string(got) != "second" {
+		t.Fatalf("Get after replacing Set = %q, want \"second\"", got)
+	}
+
+	m.Set(KeyTypeInputFinalized, []byte("done"))
+	if len(m) != 2 {
+		t.Fatalf("Set with a new key produced length %d, want 2", len(m))
+	}
+}
+
+func testPSBT() *PSBT {
+	global := Map{{Key: KeyTypeGlobalUnsignedTx, Value: []byte(`{"cryptocurrency":"BTC"}`)}}
+	inputs := []Map{
+		{{Key: KeyTypeInputWitnessUTXO, Value: []byte("utxo-0")}},
+		{{Key: KeyTypeInputWitnessUTXO, Value: []byte("utxo-1")}, {Key: KeyTypeInputPubKey, Value: []byte("pub-1")}},
+	}
+	outputs := []Map{
+		{{Key: KeyTypeOutputAddress, Value: []byte("addr-0")}, {Key: KeyTypeOutputAmount, Value: []byte("100")}},
+	}
+	return &PSBT{Global: global, Inputs: inputs, Outputs: outputs}
+}
+
+func TestSerializeDeserializeRoundTrip(t *testing.T) {
+	p := testPSBT()
+	data, err := p.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+	if !bytes.Equal(data[:len(Magic)], Magic[:]) {
+		t.Fatalf("Serialize output does not start with Magic: got %x", data[:len(Magic)])
+	}
+
+	decoded, err := Deserialize(data, len(p.Inputs), len(p.Outputs))
+	if err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+
+	for _, kv := range p.Global {
+		got, ok := decoded.Global.Get(kv.Key)
+		if !ok || !bytes.Equal(got, kv.Value) {
+			t.Fatalf("global map entry %x round-tripped as (%q, %v), want (%q, true)", kv.Key, got, ok, kv.Value)
+		}
+	}
+	if len(decoded.Inputs) != len(p.Inputs) || len(decoded.Outputs) != len(p.Outputs) {
+		t.Fatalf("decoded input/output counts = %d/%d, want %d/%d", len(decoded.Inputs), len(decoded.Outputs), len(p.Inputs), len(p.Outputs))
+	}
+	for i, in := range p.Inputs {
+		for _, kv := range in {
+			got, ok := decoded.Inputs[i].Get(kv.Key)
+			if !ok || !bytes.Equal(got, kv.Value) {
+				t.Fatalf("input %d entry %x round-tripped as (%q, %v), want (%q, true)", i, kv.Key, got, ok, kv.Value)
+			}
+		}
+	}
+	for i, out := range p.Outputs {
+		for _, kv := range out {
+			got, ok := decoded.Outputs[i].Get(kv.Key)
+			if !ok || !bytes.Equal(got, kv.Value) {
+				t.Fatalf("output %d entry %x round-tripped as (%q, %v), want (%q, true)", i, kv.Key, got, ok, kv.Value)
+			}
+		}
+	}
+}
+
+func TestDeserializeRejectsBadMagic(t *testing.T) {
+	data := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x00}
+	if _, err := Deserialize(data, 0, 0); err == nil {
+		t.Fatal("expected an error for data with invalid magic bytes")
+	}
+}
+
+func TestDeserializeRejectsTruncatedData(t *testing.T) {
+	if _, err := Deserialize(Magic[:], 0, 0); err == nil {
+		t.Fatal("expected an error for data truncated right after the magic bytes")
+	}
+}
+
+func TestBase64RoundTrip(t *testing.T) {
+	p := testPSBT()
+	encoded, err := p.Base64()
+	if err != nil {
+		t.Fatalf("Base64: %v", err)
+	}
+
+	decoded, err := FromBase64(encoded, len(p.Inputs), len(p.Outputs))
+	if err != nil {
+		t.Fatalf("FromBase64: %v", err)
+	}
+
+	reEncoded, err := decoded.Base64()
+	if err != nil {
+		t.Fatalf("Base64 on the decoded PSBT: %v", err)
+	}
+	if reEncoded != encoded {
+		t.Fatalf("round trip changed the base64 encoding:\ngot:  %s\nwant: %s", reEncoded, encoded)
+	}
+}
+
+func TestFromBase64RejectsInvalidBase64(t *testing.T) {
+	if _, err := FromBase64("not valid base64!!", 0, 0); err == nil {
+		t.Fatal("expected an error for invalid base64 input")
+	}
+}
+
+func TestUnsignedTxMarshalRoundTrip(t *testing.T) {
+	tx := UnsignedTx{
+		Cryptocurrency: "ETH",
+		Inputs:         []TxInput{{TxID: "abc123", Vout: 1, Address: "0xfrom"}},
+		Outputs:        []TxOutput{{Address: "0xto", Amount: "500000000000000000"}},
+	}
+
+	data, err := tx.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	decoded, err := UnmarshalUnsignedTx(data)
+	if err != nil {
+		t.Fatalf("UnmarshalUnsignedTx: %v", err)
+	}
+	if decoded.Cryptocurrency != tx.Cryptocurrency {
+		t.Fatalf("decoded Cryptocurrency = %q, want %q", decoded.Cryptocurrency, tx.Cryptocurrency)
+	}
+	if len(decoded.Inputs) != 1 || decoded.Inputs[0] != tx.Inputs[0] {
+		t.Fatalf("decoded Inputs = %+v, want %+v", decoded.Inputs, tx.Inputs)
+	}
+	if len(decoded.Outputs) != 1 || decoded.Outputs[0] != tx.Outputs[0] {
+		t.Fatalf("decoded Outputs = %+v, want %+v", decoded.Outputs, tx.Outputs)
+	}
+}
+
+func TestUnmarshalUnsignedTxRejectsInvalidJSON(t *testing.T) {
+	if _, err := UnmarshalUnsignedTx([]byte("not json")); err == nil {
+		t.Fatal("expected an error for invalid JSON")
+	}
+}
+
+func TestVarIntRoundTrip(t *testing.T) {
+	for _, n := range []uint64{0, 1, 0xfc, 0xfd, 0xffff, 0x10000, 0xffffffff, 0x100000000, 0xffffffffffffffff} {
+		buf := new(bytes.Buffer)
+		writeVarInt(buf, n)
+
+		got, err := readVarInt(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatalf("readVarInt(writeVarInt(%d)): %v", n, err)
+		}
+		if got != n {
+			t.Fatalf("readVarInt(writeVarInt(%d)) = %d", n, got)
+		}
+	}
+}
+
+func TestVarBytesRoundTrip(t *testing.T) {
+	for _, b := range [][]byte{nil, {}, []byte("x"), bytes.Repeat([]byte{0xab}, 300)} {
+		buf := new(bytes.Buffer)
+		writeVarBytes(buf, b)
+
+		got, err := readVarBytes(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatalf("readVarBytes(writeVarBytes(%x)): %v", b, err)
+		}
+		if !bytes.Equal(got, b) && !(len(got) == 0 && len(b) == 0) {
+			t.Fatalf("readVarBytes(writeVarBytes(%x)) = %x", b, got)
+		}
+	}
+}
+
+func TestReadVarIntRejectsTruncatedInput(t *testing.T) {
+	// 0xfd signals a 2-byte length to follow, but none is supplied.
+	if _, err := readVarInt(bytes.NewReader([]byte{0xfd})); err == nil {
+		t.Fatal("expected an error reading a truncated varint")
+	}
+}
+
+func TestReadVarBytesRejectsTruncatedInput(t *testing.T) {
+	buf := new(bytes.Buffer)
+	writeVarInt(buf, 10)
+	buf.WriteByte('x') // only 1 of the promised 10 bytes follows
+	if _, err := readVarBytes(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Fatal("expected an error reading varbytes truncated short of their declared length")
+	}
+}
+
+func TestReadVarBytesRejectsHugeDeclaredLength(t *testing.T) {
+	buf := new(bytes.Buffer)
+	writeVarInt(buf, 0xffffffffffffffff)
+	if _, err := readVarBytes(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Fatal("expected an error for a declared length far exceeding the remaining data, not a panic")
+	}
+}
@@ -0,0 +1,43 @@
+package psbt
+
+import "encoding/json"
+
+// UnsignedTx is this system's chain-agnostic stand-in for BIP-174's raw
+// unsigned Bitcoin transaction: it carries exactly the information
+// BuildUnsignedTransaction determined (which UTXOs fund the spend, who
+// gets paid, and the fee) without committing to any one chain's wire
+// format, since a PSBT may equally be funding a BTC, ETH, or BNB
+// transfer. It is stored, JSON-encoded, as the global map's
+// KeyTypeGlobalUnsignedTx value.
+type UnsignedTx struct {
+	Cryptocurrency string     `json:"cryptocurrency"`
+	Inputs         []TxInput  `json:"inputs"`
+	Outputs        []TxOutput `json:"outputs"`
+}
+
+// TxInput identifies one UTXO being spent and, once signed, carries the
+// signature that authorizes spending it.
+type TxInput struct {
+	TxID    string `json:"tx_id"`
+	Vout    uint32 `json:"vout"`
+	Address string `json:"address"`
+}
+
+// TxOutput is one destination and amount (in the transaction's own
+// minor units, e.g. satoshis) paid out by the transaction.
+type TxOutput struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// Marshal JSON-encodes tx for storage as a global map value.
+func (tx UnsignedTx) Marshal() ([]byte, error) {
+	return json.Marshal(tx)
+}
+
+// UnmarshalUnsignedTx decodes a global map value produced by Marshal.
+func UnmarshalUnsignedTx(data []byte) (UnsignedTx, error) {
+	var tx UnsignedTx
+	err := json.Unmarshal(data, &tx)
+	return tx, err
+}
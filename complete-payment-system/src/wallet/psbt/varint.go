@@ -0,0 +1,88 @@
+package psbt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// writeVarBytes writes b prefixed with its length as a Bitcoin-style
+// CompactSize integer, the same variable-length scheme BIP-174 uses for
+// every key and value in a PSBT map.
+func writeVarBytes(buf *bytes.Buffer, b []byte) {
+	writeVarInt(buf, uint64(len(b)))
+	buf.Write(b)
+}
+
+// readVarBytes reads a CompactSize length followed by that many bytes.
+func readVarBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := readVarInt(r)
+	if err != nil {
+		return nil, err
+	}
+	// A corrupted or tampered PSBT (this format's whole point is
+	// carrying untrusted data between an online and an air-gapped
+	// machine) could claim a length far larger than the data actually
+	// remaining; reject that up front instead of letting make([]byte,
+	// n) panic the process.
+	if n > uint64(r.Len()) {
+		return nil, fmt.Errorf("psbt: declared length %d exceeds remaining data", n)
+	}
+	b := make([]byte, n)
+	if n > 0 {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, fmt.Errorf("psbt: reading %d bytes: %w", n, err)
+		}
+	}
+	return b, nil
+}
+
+// writeVarInt encodes n as a Bitcoin CompactSize integer.
+func writeVarInt(buf *bytes.Buffer, n uint64) {
+	switch {
+	case n < 0xfd:
+		buf.WriteByte(byte(n))
+	case n <= 0xffff:
+		buf.WriteByte(0xfd)
+		buf.WriteByte(byte(n))
+		buf.WriteByte(byte(n >> 8))
+	case n <= 0xffffffff:
+		buf.WriteByte(0xfe)
+		for i := 0; i < 4; i++ {
+			buf.WriteByte(byte(n >> (8 * i)))
+		}
+	default:
+		buf.WriteByte(0xff)
+		for i := 0; i < 8; i++ {
+			buf.WriteByte(byte(n >> (8 * i)))
+		}
+	}
+}
+
+// readVarInt decodes a CompactSize integer written by writeVarInt.
+func readVarInt(r *bytes.Reader) (uint64, error) {
+	first, err := r.ReadByte()
+	if err != nil {
+		return 0, fmt.Errorf("psbt: reading varint prefix: %w", err)
+	}
+	var size int
+	switch first {
+	case 0xfd:
+		size = 2
+	case 0xfe:
+		size = 4
+	case 0xff:
+		size = 8
+	default:
+		return uint64(first), nil
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return 0, fmt.Errorf("psbt: reading varint: %w", err)
+	}
+	var n uint64
+	for i := 0; i < size; i++ {
+		n |= uint64(b[i]) << (8 * i)
+	}
+	return n, nil
+}
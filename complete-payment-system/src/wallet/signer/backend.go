@@ -0,0 +1,68 @@
+// Package signer abstracts where a wallet's private key actually
+// lives, so it can be a software keystore in this process, a
+// Ledger/Trezor over USB HID, or a PKCS#11 HSM/smart card — mirroring
+// go-ethereum's accounts/external and accounts/scwallet backends.
+// WalletService talks only to the SignerBackend interface below; a
+// concrete backend registers itself into a BackendRegistry (see
+// registry.go), the same self-registration convention package hd uses
+// for RegisterCoin.
+package signer
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrBackendLocked is returned by TransferFunds/SignPSBT when a
+// backend-routed wallet's backend hasn't been opened (see
+// WalletService.OpenBackend), mirroring keystore.ErrLocked's role for
+// the local keystore path.
+var ErrBackendLocked = errors.New("signer: backend is not open")
+
+// DerivationPath is a BIP-44-style path string, e.g. "m/44'/0'/0'/0/0".
+type DerivationPath string
+
+// AccountRef identifies one account a SignerBackend manages.
+type AccountRef struct {
+	BackendID string
+	Path      DerivationPath
+	Address   string
+
+	// PublicKey is the account's compressed secp256k1 public key, when
+	// the backend can supply one (a real hardware wallet returns its
+	// derived public key alongside the address; see backends/keystore
+	// for the reference implementation). It lets a caller (e.g.
+	// WalletService.FinalizeAndBroadcast) verify a SignHash result
+	// without backend-specific code.
+	PublicKey []byte
+}
+
+// SignerBackend is implemented by anything that can list, derive, and
+// sign on behalf of accounts whose private keys it alone holds.
+type SignerBackend interface {
+	// List returns every account this backend currently knows about
+	// (e.g. every keystore entry, or every account a connected hardware
+	// device currently exposes).
+	List() ([]AccountRef, error)
+
+	// Derive returns the AccountRef at path, deriving and registering it
+	// with the backend first if this is the first time path has been
+	// asked for.
+	Derive(path DerivationPath) (AccountRef, error)
+
+	// SignHash signs a pre-computed hash with acct's private key.
+	SignHash(acct AccountRef, hash []byte) ([]byte, error)
+
+	// SignTx signs rawTx for acct, using chainID where the backend's
+	// signature scheme is chain-ID-aware (e.g. EIP-155); chainID may be
+	// nil for chains with no such replay-protection scheme.
+	SignTx(acct AccountRef, rawTx []byte, chainID *big.Int) ([]byte, error)
+
+	// Open authorizes use of this backend's accounts (e.g. decrypting a
+	// keystore file, or prompting a hardware device for a PIN), until
+	// Close is called.
+	Open(passphrase string) error
+
+	// Close revokes the authorization Open granted.
+	Close() error
+}
@@ -0,0 +1,22 @@
+package signer
+
+// BackendEventType distinguishes the kinds of events a BackendRegistry
+// publishes.
+type BackendEventType string
+
+const (
+	// BackendEventConnected is published when a backend is registered
+	// (e.g. a hardware wallet is plugged in and its backend finishes
+	// enumerating it).
+	BackendEventConnected BackendEventType = "connected"
+
+	// BackendEventDisconnected is published when a backend is
+	// unregistered (e.g. a hardware wallet is unplugged).
+	BackendEventDisconnected BackendEventType = "disconnected"
+)
+
+// BackendEvent is one connect/disconnect notification for BackendID.
+type BackendEvent struct {
+	BackendID string
+	Type      BackendEventType
+}
@@ -0,0 +1,95 @@
+package signer
+
+import "sync"
+
+// BackendRegistry tracks every SignerBackend a process has made
+// available, keyed by backend ID (e.g. "keystore", "ledger",
+// "pkcs11"), and fans out connect/disconnect notifications to
+// subscribers. WalletService holds one via its Backends field.
+type BackendRegistry struct {
+	mu       sync.RWMutex
+	backends map[string]SignerBackend
+	subs     []chan BackendEvent
+}
+
+// NewBackendRegistry creates an empty BackendRegistry.
+func NewBackendRegistry() *BackendRegistry {
+	return &BackendRegistry{backends: make(map[string]SignerBackend)}
+}
+
+// DefaultRegistry is the process-wide BackendRegistry the package-level
+// Register and Lookup delegate to, mirroring net/http.DefaultServeMux —
+// the three backends/* packages register into this at init time.
+var DefaultRegistry = NewBackendRegistry()
+
+// Register adds backend under id to DefaultRegistry and publishes a
+// BackendEventConnected event.
+func Register(id string, backend SignerBackend) {
+	DefaultRegistry.Register(id, backend)
+}
+
+// Lookup returns the backend registered under id in DefaultRegistry.
+func Lookup(id string) (SignerBackend, bool) {
+	return DefaultRegistry.Lookup(id)
+}
+
+// Register adds backend under id, replacing any existing registration,
+// and publishes a BackendEventConnected event for id.
+func (r *BackendRegistry) Register(id string, backend SignerBackend) {
+	r.mu.Lock()
+	r.backends[id] = backend
+	r.mu.Unlock()
+	r.Publish(BackendEvent{BackendID: id, Type: BackendEventConnected})
+}
+
+// Unregister removes id's registration, if any, and publishes a
+// BackendEventDisconnected event for id.
+func (r *BackendRegistry) Unregister(id string) {
+	r.mu.Lock()
+	_, ok := r.backends[id]
+	delete(r.backends, id)
+	r.mu.Unlock()
+	if ok {
+		r.Publish(BackendEvent{BackendID: id, Type: BackendEventDisconnected})
+	}
+}
+
+// Lookup returns the backend registered under id.
+func (r *BackendRegistry) Lookup(id string) (SignerBackend, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	b, ok := r.backends[id]
+	return b, ok
+}
+
+// IDs returns every currently-registered backend ID.
+func (r *BackendRegistry) IDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]string, 0, len(r.backends))
+	for id := range r.backends {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Subscribe registers ch to receive future BackendEvents. Delivery is
+// non-blocking: a subscriber that isn't keeping up with Publish simply
+// misses events rather than stalling the registry.
+func (r *BackendRegistry) Subscribe(ch chan BackendEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subs = append(r.subs, ch)
+}
+
+// Publish delivers event to every subscriber registered via Subscribe.
+func (r *BackendRegistry) Publish(event BackendEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, ch := range r.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
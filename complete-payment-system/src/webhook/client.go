@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aucoventureresearchcentre/cryptowallet-integrate-asia-payment-system/core/notification"
+)
+
+// Client is the production notification.WebhookClient: it signs each
+// outbound POST with SignatureHeaderValue, tags it with an
+// idempotency key derived from the notification's ID, and records the
+// delivery's attempt count, response code, and latency onto
+// n.Metadata.
+type Client struct {
+	Transport WebhookTransport
+
+	// Secret signs outbound requests. Unlike Verifier, a sender only
+	// ever signs with its single current secret — multi-secret support
+	// exists on the receiving (Verifier) side, to give receivers a
+	// grace period while they roll over to a new one.
+	Secret string
+
+	// Events records every delivery attempt (status code and a
+	// truncated response body), independent of the lighter-weight
+	// record recordDeliveryMetadata always keeps on n.Metadata. Set by
+	// NewClient to a MemoryEventStore; replace with a RedisEventStore
+	// (or nil, to disable) for a multi-instance deployment or to opt
+	// out entirely.
+	Events EventStore
+}
+
+// NewClient creates a Client that signs with secret, sends over the
+// default HTTPTransport, and records delivery attempts to a
+// MemoryEventStore.
+func NewClient(secret string) *Client {
+	return &Client{Transport: NewHTTPTransport(), Secret: secret, Events: NewMemoryEventStore()}
+}
+
+// SendWebhook implements notification.WebhookClient.
+func (c *Client) SendWebhook(ctx context.Context, n *notification.Notification) error {
+	if c.Transport == nil {
+		return fmt.Errorf("webhook: no transport configured")
+	}
+
+	body := []byte(n.Content)
+	timestamp := time.Now().Unix()
+	headers := map[string]string{
+		SignatureHeader:      SignatureHeaderValue(c.Secret, timestamp, body),
+		IdempotencyKeyHeader: n.ID,
+	}
+
+	start := time.Now()
+	statusCode, responseBody, err := c.Transport.Send(ctx, n.Recipient, headers, body)
+	latency := time.Since(start)
+
+	recordDeliveryMetadata(n, statusCode, latency, err)
+	c.recordEvent(ctx, n, statusCode, responseBody, err)
+
+	if err != nil {
+		return fmt.Errorf("webhook: delivering %s: %w", n.ID, err)
+	}
+	if statusCode < 200 || statusCode >= 300 {
+		return fmt.Errorf("webhook: %s received status %d", n.ID, statusCode)
+	}
+	return nil
+}
+
+// recordEvent persists this attempt to c.Events, if configured. A
+// store failure is deliberately swallowed — losing delivery history
+// must never fail (or retry) a delivery that otherwise succeeded.
+func (c *Client) recordEvent(ctx context.Context, n *notification.Notification, statusCode int, responseBody []byte, sendErr error) {
+	if c.Events == nil {
+		return
+	}
+
+	attempt := DeliveryAttempt{
+		EventID:        n.ID,
+		NotificationID: n.ID,
+		Attempt:        n.Attempts,
+		StatusCode:     statusCode,
+		ResponseBody:   truncateResponseBody(responseBody),
+		SentAt:         time.Now(),
+	}
+	if sendErr != nil {
+		attempt.Error = sendErr.Error()
+	}
+	_ = c.Events.RecordAttempt(ctx, attempt)
+}
+
+// recordDeliveryMetadata appends this attempt's outcome to n.Metadata
+// under "webhook_deliveries", so every attempt (not just the latest)
+// stays visible for troubleshooting.
+func recordDeliveryMetadata(n *notification.Notification, statusCode int, latency time.Duration, sendErr error) {
+	if n.Metadata == nil {
+		n.Metadata = make(map[string]interface{})
+	}
+
+	attempt := map[string]interface{}{
+		"attempt":     n.Attempts,
+		"status_code": statusCode,
+		"latency_ms":  latency.Milliseconds(),
+	}
+	if sendErr != nil {
+		attempt["error"] = sendErr.Error()
+	}
+
+	deliveries, _ := n.Metadata["webhook_deliveries"].([]interface{})
+	n.Metadata["webhook_deliveries"] = append(deliveries, attempt)
+}
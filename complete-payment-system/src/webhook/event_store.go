@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// maxStoredResponseBody caps how much of a receiver's response body
+// RecordAttempt keeps, independent of maxResponseBodyBytes (Send's own
+// read cap) so a store backend can impose a tighter limit than the
+// transport does.
+const maxStoredResponseBody = 4096
+
+// DeliveryAttempt is one recorded attempt to deliver a webhook event.
+// EventID doubles as the idempotency key sent in IdempotencyKeyHeader,
+// so a merchant correlating delivery history with what their endpoint
+// received can do so on that value alone.
+type DeliveryAttempt struct {
+	EventID        string    `json:"event_id"`
+	NotificationID string    `json:"notification_id"`
+	Attempt        int       `json:"attempt"`
+	StatusCode     int       `json:"status_code"`
+	ResponseBody   string    `json:"response_body,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	SentAt         time.Time `json:"sent_at"`
+}
+
+// EventStore persists every webhook delivery attempt Client makes,
+// independent of notification.NotificationStore's current-state view,
+// so a merchant can inspect what was actually sent and received before
+// deciding to replay a notification via
+// notification.NotificationService.ReplayWebhook.
+type EventStore interface {
+	RecordAttempt(ctx context.Context, attempt DeliveryAttempt) error
+	ListAttempts(ctx context.Context, notificationID string) ([]DeliveryAttempt, error)
+}
+
+// truncateResponseBody trims body to maxStoredResponseBody bytes so a
+// single chatty endpoint can't dominate a store's storage.
+func truncateResponseBody(body []byte) string {
+	if len(body) > maxStoredResponseBody {
+		body = body[:maxStoredResponseBody]
+	}
+	return string(body)
+}
+
+// MemoryEventStore is an in-memory EventStore, suitable for a single
+// gateway process.
+type MemoryEventStore struct {
+	mu       sync.Mutex
+	attempts map[string][]DeliveryAttempt
+}
+
+// NewMemoryEventStore creates an empty MemoryEventStore.
+func NewMemoryEventStore() *MemoryEventStore {
+	return &MemoryEventStore{attempts: make(map[string][]DeliveryAttempt)}
+}
+
+// RecordAttempt implements EventStore.
+func (s *MemoryEventStore) RecordAttempt(ctx context.Context, attempt DeliveryAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts[attempt.NotificationID] = append(s.attempts[attempt.NotificationID], attempt)
+	return nil
+}
+
+// ListAttempts implements EventStore.
+func (s *MemoryEventStore) ListAttempts(ctx context.Context, notificationID string) ([]DeliveryAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]DeliveryAttempt(nil), s.attempts[notificationID]...), nil
+}
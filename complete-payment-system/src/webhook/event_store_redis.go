@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisEventStore is a Redis-backed EventStore, letting multiple
+// gateway instances share delivery history instead of each keeping its
+// own. Attempts for a notification are kept in a Redis list under that
+// notification's key, oldest first, matching RecordAttempt's append
+// order.
+type RedisEventStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisEventStore creates a RedisEventStore using client,
+// namespacing all keys under prefix (e.g. "webhook_events") so it can
+// share a Redis instance with other subsystems.
+func NewRedisEventStore(client *redis.Client, prefix string) *RedisEventStore {
+	return &RedisEventStore{client: client, prefix: prefix}
+}
+
+func (s *RedisEventStore) key(notificationID string) string {
+	return s.prefix + ":" + notificationID
+}
+
+// RecordAttempt implements EventStore.
+func (s *RedisEventStore) RecordAttempt(ctx context.Context, attempt DeliveryAttempt) error {
+	data, err := json.Marshal(attempt)
+	if err != nil {
+		return fmt.Errorf("webhook: encoding delivery attempt: %w", err)
+	}
+	if err := s.client.RPush(ctx, s.key(attempt.NotificationID), data).Err(); err != nil {
+		return fmt.Errorf("webhook: recording delivery attempt: %w", err)
+	}
+	return nil
+}
+
+// ListAttempts implements EventStore.
+func (s *RedisEventStore) ListAttempts(ctx context.Context, notificationID string) ([]DeliveryAttempt, error) {
+	raw, err := s.client.LRange(ctx, s.key(notificationID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("webhook: reading delivery attempts: %w", err)
+	}
+
+	attempts := make([]DeliveryAttempt, 0, len(raw))
+	for _, item := range raw {
+		var attempt DeliveryAttempt
+		if err := json.Unmarshal([]byte(item), &attempt); err != nil {
+			return nil, fmt.Errorf("webhook: decoding delivery attempt: %w", err)
+		}
+		attempts = append(attempts, attempt)
+	}
+	return attempts, nil
+}
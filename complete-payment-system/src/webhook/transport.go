@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxResponseBodyBytes caps how much of a receiver's response body Send
+// reads into memory, so a misbehaving endpoint that streams megabytes
+// back can't bloat an EventStore record or this process's memory.
+const maxResponseBodyBytes = 64 * 1024
+
+// WebhookTransport sends a signed webhook request and reports the
+// receiver's status code and response body, so Client can be unit
+// tested against a fake without making real network calls.
+type WebhookTransport interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (statusCode int, responseBody []byte, err error)
+}
+
+// HTTPTransport is the production WebhookTransport, backed by an
+// *http.Client.
+type HTTPTransport struct {
+	HTTPClient *http.Client
+}
+
+// NewHTTPTransport creates an HTTPTransport with a sane default
+// request timeout.
+func NewHTTPTransport() *HTTPTransport {
+	return &HTTPTransport{HTTPClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Send implements WebhookTransport.
+func (t *HTTPTransport) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, fmt.Errorf("webhook: building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	client := t.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("webhook: sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyBytes))
+	io.Copy(io.Discard, resp.Body) // drain anything past the cap so the connection can be reused
+
+	return resp.StatusCode, responseBody, nil
+}
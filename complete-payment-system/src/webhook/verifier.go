@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTolerance is how far a webhook's timestamp may drift from now
+// before Verifier rejects it as a replay.
+const DefaultTolerance = 5 * time.Minute
+
+// Verifier validates incoming webhook callbacks signed with
+// SignatureHeaderValue. Other Go services import this type to check
+// deliveries from this payment system the same way this package's own
+// Client signs them.
+type Verifier struct {
+	mu        sync.RWMutex
+	secrets   []string
+	Tolerance time.Duration
+}
+
+// NewVerifier creates a Verifier accepting signatures from any of
+// secrets (supporting key rotation: keep the old secret alongside the
+// new one until every in-flight delivery has been verified, then drop
+// it) and rejecting timestamps outside DefaultTolerance.
+func NewVerifier(secrets ...string) *Verifier {
+	return &Verifier{secrets: append([]string(nil), secrets...), Tolerance: DefaultTolerance}
+}
+
+// AddSecret registers an additional signing secret, for rotating in a
+// new key before retiring the old one.
+func (v *Verifier) AddSecret(secret string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.secrets = append(v.secrets, secret)
+}
+
+// Verify checks signatureHeader (the SignatureHeader value) against
+// body, returning an error if the timestamp is outside v.Tolerance or
+// the signature doesn't match any configured secret.
+func (v *Verifier) Verify(signatureHeader string, body []byte) error {
+	timestamp, signature, err := parseSignatureHeader(signatureHeader)
+	if err != nil {
+		return err
+	}
+
+	tolerance := v.Tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	age := time.Since(time.Unix(timestamp, 0))
+	if age < 0 {
+		age = -age
+	}
+	if age > tolerance {
+		return fmt.Errorf("webhook: timestamp %d outside tolerance window (%s)", timestamp, tolerance)
+	}
+
+	v.mu.RLock()
+	secrets := v.secrets
+	v.mu.RUnlock()
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("webhook: malformed signature: %w", err)
+	}
+	for _, secret := range secrets {
+		candidate, err := hex.DecodeString(sign(secret, timestamp, body))
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(candidate, expected) {
+			return nil
+		}
+	}
+	return fmt.Errorf("webhook: signature does not match any configured secret")
+}
+
+// parseSignatureHeader splits a "t=<unix>,v1=<hex>" header value into
+// its timestamp and signature components.
+func parseSignatureHeader(header string) (timestamp int64, signature string, err error) {
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			timestamp, err = strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return 0, "", fmt.Errorf("webhook: malformed timestamp: %w", err)
+			}
+		case "v1":
+			signature = kv[1]
+		}
+	}
+	if timestamp == 0 || signature == "" {
+		return 0, "", fmt.Errorf("webhook: malformed signature header %q", header)
+	}
+	return timestamp, signature, nil
+}
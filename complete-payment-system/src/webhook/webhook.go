@@ -0,0 +1,35 @@
+// Package webhook signs and verifies outbound notification callbacks
+// the way modern payment processors (Stripe, Adyen, ...) do: an
+// HMAC-SHA256 signature over "timestamp.body" carried in the
+// X-CryptoWallet-Signature header, so a receiver can reject stale or
+// forged requests without a shared transport-level secret.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// SignatureHeader is the HTTP header name carrying the signature.
+const SignatureHeader = "X-CryptoWallet-Signature"
+
+// IdempotencyKeyHeader is the HTTP header name carrying the delivery's
+// idempotency key, so a receiver can dedupe retried deliveries of the
+// same notification.
+const IdempotencyKeyHeader = "X-CryptoWallet-Idempotency-Key"
+
+// sign computes the hex-encoded HMAC-SHA256 of "timestamp.body" under
+// secret.
+func sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.%s", timestamp, body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignatureHeaderValue builds the "t=<unix>,v1=<hex>" value sent in
+// SignatureHeader, signed with secret.
+func SignatureHeaderValue(secret string, timestamp int64, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, sign(secret, timestamp, body))
+}